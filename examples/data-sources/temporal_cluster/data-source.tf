@@ -0,0 +1,23 @@
+data "temporal_cluster" "current" {}
+
+resource "temporal_schedule" "example" {
+  name = "example"
+
+  action {
+    workflow_type   = "exampleWorkflow"
+    task_queue_name = "example-task-queue"
+  }
+
+  spec {
+    interval {
+      every = "1d"
+    }
+  }
+
+  lifecycle {
+    precondition {
+      condition     = data.temporal_cluster.current.capabilities.supports_schedules
+      error_message = "The Temporal cluster does not support schedules."
+    }
+  }
+}
@@ -0,0 +1,3 @@
+data "temporal_namespace" "example" {
+  name = "example"
+}
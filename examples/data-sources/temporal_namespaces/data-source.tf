@@ -0,0 +1,11 @@
+data "temporal_namespaces" "team_a" {
+  name_prefix = "team-a-"
+  state       = "registered"
+}
+
+resource "temporal_search_attribute" "customer_id" {
+  for_each  = { for ns in data.temporal_namespaces.team_a.namespaces : ns.name => ns }
+  namespace = each.key
+  name      = "CustomerId"
+  type      = "Keyword"
+}
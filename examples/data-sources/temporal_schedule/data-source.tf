@@ -0,0 +1,4 @@
+data "temporal_schedule" "example" {
+  namespace = "default"
+  name      = "example"
+}
@@ -0,0 +1,4 @@
+data "temporal_schedules" "paused" {
+  namespace = "default"
+  query     = "TemporalSchedulePaused = true"
+}
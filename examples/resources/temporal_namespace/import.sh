@@ -1,2 +1,5 @@
+# Import a namespace using its name
+terraform import temporal_namespace.example example
+
 # Import a namespace using its ID
-terraform import temporal_namespace.example 824ff1b0-3b8b-43b2-8742-bc74796cb043
\ No newline at end of file
+terraform import temporal_namespace.example 824ff1b0-3b8b-43b2-8742-bc74796cb043
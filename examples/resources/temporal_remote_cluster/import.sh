@@ -0,0 +1,2 @@
+# Import a remote cluster using its name
+terraform import temporal_remote_cluster.standby standby
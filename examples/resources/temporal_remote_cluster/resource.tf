@@ -0,0 +1,3 @@
+resource "temporal_remote_cluster" "standby" {
+  frontend_address = "temporal-standby.example.com:7233"
+}
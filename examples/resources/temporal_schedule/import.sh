@@ -1,2 +1,6 @@
-# Import a schedule using its name
-terraform import temporal_schedule.example <schedule name>
\ No newline at end of file
+# Import a schedule using its name, in the provider namespace
+terraform import temporal_schedule.example <schedule name>
+
+# Import a schedule from another namespace. A schedule name containing "/"
+# must always be prefixed with its namespace.
+terraform import temporal_schedule.example <namespace>/<schedule name>
@@ -0,0 +1,5 @@
+# Import a search attribute using its name, in the provider namespace
+terraform import temporal_search_attribute.customer_id CustomerId
+
+# Import a search attribute from another namespace
+terraform import temporal_search_attribute.customer_id example/CustomerId
@@ -0,0 +1,5 @@
+resource "temporal_search_attribute" "customer_id" {
+  namespace = temporal_namespace.example.name
+  name      = "CustomerId"
+  type      = "Keyword"
+}
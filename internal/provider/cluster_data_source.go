@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"go.temporal.io/api/workflowservice/v1"
+	temporal "go.temporal.io/sdk/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &clusterDataSource{}
+	_ datasource.DataSourceWithConfigure = &clusterDataSource{}
+)
+
+func NewClusterDataSource() datasource.DataSource {
+	return &clusterDataSource{}
+}
+
+type clusterDataSource struct {
+	client temporal.Client
+}
+
+type clusterCapabilitiesModel struct {
+	SignalAndQueryHeader            basetypes.BoolValue `tfsdk:"signal_and_query_header"`
+	InternalErrorDifferentiation    basetypes.BoolValue `tfsdk:"internal_error_differentiation"`
+	ActivityFailureIncludeHeartbeat basetypes.BoolValue `tfsdk:"activity_failure_include_heartbeat"`
+	SupportsSchedules               basetypes.BoolValue `tfsdk:"supports_schedules"`
+	EncodedFailureAttributes        basetypes.BoolValue `tfsdk:"encoded_failure_attributes"`
+	BuildIdBasedVersioning          basetypes.BoolValue `tfsdk:"build_id_based_versioning"`
+	UpsertMemo                      basetypes.BoolValue `tfsdk:"upsert_memo"`
+	EagerWorkflowStart              basetypes.BoolValue `tfsdk:"eager_workflow_start"`
+	SdkMetadata                     basetypes.BoolValue `tfsdk:"sdk_metadata"`
+	CountGroupByExecutionStatus     basetypes.BoolValue `tfsdk:"count_group_by_execution_status"`
+}
+
+type clusterDataSourceModel struct {
+	ClusterName       basetypes.StringValue    `tfsdk:"cluster_name"`
+	ClusterID         basetypes.StringValue    `tfsdk:"cluster_id"`
+	ServerVersion     basetypes.StringValue    `tfsdk:"server_version"`
+	HistoryShardCount basetypes.Int64Value     `tfsdk:"history_shard_count"`
+	PersistenceStore  basetypes.StringValue    `tfsdk:"persistence_store"`
+	VisibilityStore   basetypes.StringValue    `tfsdk:"visibility_store"`
+	SupportedClients  map[string]string        `tfsdk:"supported_clients"`
+	Capabilities      clusterCapabilitiesModel `tfsdk:"capabilities"`
+}
+
+func parseClusterDataSource(cluster *workflowservice.GetClusterInfoResponse, system *workflowservice.GetSystemInfoResponse) *clusterDataSourceModel {
+	capabilities := system.GetCapabilities()
+	return &clusterDataSourceModel{
+		ClusterName:       types.StringValue(cluster.GetClusterName()),
+		ClusterID:         types.StringValue(cluster.GetClusterId()),
+		ServerVersion:     types.StringValue(cluster.GetServerVersion()),
+		HistoryShardCount: types.Int64Value(int64(cluster.GetHistoryShardCount())),
+		PersistenceStore:  types.StringValue(cluster.GetPersistenceStore()),
+		VisibilityStore:   types.StringValue(cluster.GetVisibilityStore()),
+		SupportedClients:  cluster.GetSupportedClients(),
+		Capabilities: clusterCapabilitiesModel{
+			SignalAndQueryHeader:            types.BoolValue(capabilities.GetSignalAndQueryHeader()),
+			InternalErrorDifferentiation:    types.BoolValue(capabilities.GetInternalErrorDifferentiation()),
+			ActivityFailureIncludeHeartbeat: types.BoolValue(capabilities.GetActivityFailureIncludeHeartbeat()),
+			SupportsSchedules:               types.BoolValue(capabilities.GetSupportsSchedules()),
+			EncodedFailureAttributes:        types.BoolValue(capabilities.GetEncodedFailureAttributes()),
+			BuildIdBasedVersioning:          types.BoolValue(capabilities.GetBuildIdBasedVersioning()),
+			UpsertMemo:                      types.BoolValue(capabilities.GetUpsertMemo()),
+			EagerWorkflowStart:              types.BoolValue(capabilities.GetEagerWorkflowStart()),
+			SdkMetadata:                     types.BoolValue(capabilities.GetSdkMetadata()),
+			CountGroupByExecutionStatus:     types.BoolValue(capabilities.GetCountGroupByExecutionStatus()),
+		},
+	}
+}
+
+func (d *clusterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = cfg.client
+}
+
+// Metadata returns the data source type name.
+func (d *clusterDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cluster"
+}
+
+// Schema defines the schema for the data source.
+func (d *clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `temporal_cluster` data source exposes information about the Temporal cluster the provider is connected to, e.g. to check in preconditions that it supports a feature before using it.",
+		Attributes: map[string]schema.Attribute{
+			"cluster_name": schema.StringAttribute{
+				Description: "Name of the cluster.",
+				Computed:    true,
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "ID of the cluster.",
+				Computed:    true,
+			},
+			"server_version": schema.StringAttribute{
+				Description: "Version of the Temporal server.",
+				Computed:    true,
+			},
+			"history_shard_count": schema.Int64Attribute{
+				Description: "Number of history shards of the cluster.",
+				Computed:    true,
+			},
+			"persistence_store": schema.StringAttribute{
+				MarkdownDescription: "Persistence store of the cluster, e.g. `postgres12`.",
+				Computed:            true,
+			},
+			"visibility_store": schema.StringAttribute{
+				MarkdownDescription: "Visibility store of the cluster, e.g. `elasticsearch`.",
+				Computed:            true,
+			},
+			"supported_clients": schema.MapAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Version ranges of the clients supported by the server, by client name, e.g. `temporal-go`.",
+				Computed:            true,
+			},
+			"capabilities": schema.SingleNestedAttribute{
+				Description: "Capabilities reported by the server.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"signal_and_query_header": schema.BoolAttribute{
+						Description: "Whether signal and query headers are supported.",
+						Computed:    true,
+					},
+					"internal_error_differentiation": schema.BoolAttribute{
+						Description: "Whether internal errors are differentiated from other errors.",
+						Computed:    true,
+					},
+					"activity_failure_include_heartbeat": schema.BoolAttribute{
+						Description: "Whether activity failures include the last heartbeat details.",
+						Computed:    true,
+					},
+					"supports_schedules": schema.BoolAttribute{
+						Description: "Whether schedules are supported.",
+						Computed:    true,
+					},
+					"encoded_failure_attributes": schema.BoolAttribute{
+						Description: "Whether encoded failure attributes are supported.",
+						Computed:    true,
+					},
+					"build_id_based_versioning": schema.BoolAttribute{
+						Description: "Whether worker versioning based on build IDs is supported.",
+						Computed:    true,
+					},
+					"upsert_memo": schema.BoolAttribute{
+						Description: "Whether workflows can upsert their memo.",
+						Computed:    true,
+					},
+					"eager_workflow_start": schema.BoolAttribute{
+						Description: "Whether eager workflow start is supported.",
+						Computed:    true,
+					},
+					"sdk_metadata": schema.BoolAttribute{
+						Description: "Whether SDK metadata is recorded in workflow task completions.",
+						Computed:    true,
+					},
+					"count_group_by_execution_status": schema.BoolAttribute{
+						Description: "Whether workflow counts can be grouped by execution status.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	cluster, err := d.client.WorkflowService().GetClusterInfo(ctx, &workflowservice.GetClusterInfoRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the cluster info", serviceErrorDetail(err))
+		return
+	}
+
+	system, err := d.client.WorkflowService().GetSystemInfo(ctx, &workflowservice.GetSystemInfoRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the system info", serviceErrorDetail(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, parseClusterDataSource(cluster, system))...)
+}
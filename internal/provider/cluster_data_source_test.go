@@ -0,0 +1,26 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccClusterDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig + `
+data "temporal_cluster" "current" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporal_cluster.current", "cluster_name", "active"),
+					resource.TestCheckResourceAttrSet("data.temporal_cluster.current", "cluster_id"),
+					resource.TestCheckResourceAttrSet("data.temporal_cluster.current", "server_version"),
+					resource.TestCheckResourceAttrSet("data.temporal_cluster.current", "history_shard_count"),
+					resource.TestCheckResourceAttr("data.temporal_cluster.current", "capabilities.supports_schedules", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,148 @@
+package provider
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// Metadata used by encrypted payloads, compatible with the encryption
+	// codec of the Temporal samples.
+	metadataEncodingEncrypted = "binary/encrypted"
+	metadataEncryptionKeyID   = "encryption-key-id"
+)
+
+// codecConfig holds the payload codec configured on the provider.
+type codecConfig struct {
+	endpoint string
+	headers  map[string]string
+	aes      *aesGCMCodec
+}
+
+// payloadCodec returns the codec to use for payloads of the given namespace,
+// or nil when no codec is configured.
+func (c *codecConfig) payloadCodec(namespace string) converter.PayloadCodec {
+	if c == nil {
+		return nil
+	}
+	if c.aes != nil {
+		return c.aes
+	}
+	return converter.NewRemotePayloadCodec(converter.RemotePayloadCodecOptions{
+		Endpoint: c.endpoint,
+		ModifyRequest: func(req *http.Request) error {
+			req.Header.Set("X-Namespace", namespace)
+			for k, v := range c.headers {
+				req.Header.Set(k, v)
+			}
+			return nil
+		},
+	})
+}
+
+// aesGCMCodec encrypts whole payloads with AES-GCM, prefixing the ciphertext
+// with the nonce.
+type aesGCMCodec struct {
+	keyID string
+	aead  cipher.AEAD
+}
+
+func newAESGCMCodec(keyID string, key []byte) (*aesGCMCodec, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &aesGCMCodec{keyID: keyID, aead: aead}, nil
+}
+
+// parseAESKey accepts the base64 encoding of a 16, 24 or 32 bytes key, or the
+// raw key itself. Base64 is tried first as it is ambiguous with raw 24 or 32
+// bytes keys made of printable characters.
+func parseAESKey(key []byte) ([]byte, error) {
+	// Tolerate the trailing newline of key files.
+	trimmed := bytes.TrimSpace(key)
+	if decoded, err := base64.StdEncoding.DecodeString(string(trimmed)); err == nil && validAESKeyLength(decoded) {
+		return decoded, nil
+	}
+	if validAESKeyLength(key) {
+		return key, nil
+	}
+	if validAESKeyLength(trimmed) {
+		return trimmed, nil
+	}
+	return nil, errors.New("the AES key must be 16, 24 or 32 bytes long, either raw or base64 encoded")
+}
+
+func validAESKeyLength(key []byte) bool {
+	switch len(key) {
+	case 16, 24, 32:
+		return true
+	}
+	return false
+}
+
+// Encode implements converter.PayloadCodec.
+func (c *aesGCMCodec) Encode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	result := make([]*commonpb.Payload, len(payloads))
+	for i, p := range payloads {
+		plaintext, err := proto.Marshal(p)
+		if err != nil {
+			return payloads, err
+		}
+		nonce := make([]byte, c.aead.NonceSize())
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+			return payloads, err
+		}
+		result[i] = &commonpb.Payload{
+			Metadata: map[string][]byte{
+				converter.MetadataEncoding: []byte(metadataEncodingEncrypted),
+				metadataEncryptionKeyID:    []byte(c.keyID),
+			},
+			Data: c.aead.Seal(nonce, nonce, plaintext, nil),
+		}
+	}
+	return result, nil
+}
+
+// Decode implements converter.PayloadCodec. Payloads that are not encrypted
+// are returned as is.
+func (c *aesGCMCodec) Decode(payloads []*commonpb.Payload) ([]*commonpb.Payload, error) {
+	result := make([]*commonpb.Payload, len(payloads))
+	for i, p := range payloads {
+		if string(p.GetMetadata()[converter.MetadataEncoding]) != metadataEncodingEncrypted {
+			result[i] = p
+			continue
+		}
+		if keyID := string(p.GetMetadata()[metadataEncryptionKeyID]); keyID != c.keyID {
+			return payloads, fmt.Errorf("payload encrypted with unknown key %q", keyID)
+		}
+		data := p.GetData()
+		if len(data) < c.aead.NonceSize() {
+			return payloads, errors.New("encrypted payload too short")
+		}
+		plaintext, err := c.aead.Open(nil, data[:c.aead.NonceSize()], data[c.aead.NonceSize():], nil)
+		if err != nil {
+			return payloads, fmt.Errorf("decrypting payload: %w", err)
+		}
+		result[i] = &commonpb.Payload{}
+		if err := proto.Unmarshal(plaintext, result[i]); err != nil {
+			return payloads, err
+		}
+	}
+	return result, nil
+}
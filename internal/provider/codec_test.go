@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAESGCMCodec(t *testing.T) {
+	codec, err := newAESGCMCodec("test", []byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := []*commonpb.Payload{jsonPayload(`{"foo":"bar"}`)}
+	encoded, err := codec.Encode(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded[0].Metadata[converter.MetadataEncoding]) != metadataEncodingEncrypted {
+		t.Fatalf("payload not encrypted: %v", encoded[0])
+	}
+
+	// Unencrypted payloads are passed through.
+	decoded, err := codec.Decode(append(encoded, jsonPayload(`"plain"`)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(decoded[0], input[0]) || string(decoded[1].Data) != `"plain"` {
+		t.Fatalf("unexpected decoded payloads: %v", decoded)
+	}
+
+	other, err := newAESGCMCodec("other", []byte("0123456789abcdef0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := other.Decode(encoded); err == nil {
+		t.Fatal("expected an error decoding with an unknown key")
+	}
+}
+
+func TestParseAESKey(t *testing.T) {
+	for _, key := range []string{"0123456789abcdef", "0123456789abcdef\n", "MDEyMzQ1Njc4OWFiY2RlZg=="} {
+		if parsed, err := parseAESKey([]byte(key)); err != nil || len(parsed) != 16 {
+			t.Errorf("parseAESKey(%q) = %v, %v", key, parsed, err)
+		}
+	}
+	if _, err := parseAESKey([]byte("short")); err == nil {
+		t.Error("expected an error for a short key")
+	}
+}
+
+func TestRemoteCodec(t *testing.T) {
+	aesCodec, err := newAESGCMCodec("test", []byte("0123456789abcdef"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := converter.NewPayloadCodecHTTPHandler(aesCodec)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Namespace") != "example" || r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "unexpected headers", http.StatusForbidden)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	config, err := buildCodecConfig(&codecModel{
+		Endpoint: types.StringValue(server.URL + "/"),
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	codec := config.payloadCodec("example")
+
+	input := []*commonpb.Payload{jsonPayload(`[1,2,3]`)}
+	encoded, err := codec.Encode(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(encoded[0].Metadata[converter.MetadataEncoding]) != metadataEncodingEncrypted {
+		t.Fatalf("payload not encoded by the remote codec: %v", encoded[0])
+	}
+	decoded, err := codec.Decode(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(decoded[0], input[0]) {
+		t.Fatalf("unexpected decoded payload: %v", decoded[0])
+	}
+
+	if (*codecConfig)(nil).payloadCodec("example") != nil {
+		t.Fatal("expected no codec when none is configured")
+	}
+}
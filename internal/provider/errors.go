@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"errors"
+
+	"go.temporal.io/api/serviceerror"
+	sdktemporal "go.temporal.io/sdk/temporal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// isNotFound reports whether err means that the object, or the namespace it
+// lives in, does not exist.
+func isNotFound(err error) bool {
+	var notFound *serviceerror.NotFound
+	var namespaceNotFound *serviceerror.NamespaceNotFound
+	return errors.As(err, &notFound) || errors.As(err, &namespaceNotFound)
+}
+
+// isAlreadyExists reports whether err means that the object being created
+// already exists.
+func isAlreadyExists(err error) bool {
+	var alreadyExists *serviceerror.AlreadyExists
+	var namespaceAlreadyExists *serviceerror.NamespaceAlreadyExists
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	return errors.As(err, &alreadyExists) || errors.As(err, &namespaceAlreadyExists) || errors.As(err, &alreadyStarted) ||
+		errors.Is(err, sdktemporal.ErrScheduleAlreadyRunning)
+}
+
+// serviceErrorDetail describes an error returned by the Temporal server,
+// followed by a hint on how to solve it depending on its class.
+func serviceErrorDetail(err error) string {
+	if hint := serviceErrorHint(err); hint != "" {
+		return err.Error() + "\n\n" + hint
+	}
+	return err.Error()
+}
+
+func serviceErrorHint(err error) string {
+	var (
+		namespaceNotFound  *serviceerror.NamespaceNotFound
+		namespaceNotActive *serviceerror.NamespaceNotActive
+		namespaceInvalid   *serviceerror.NamespaceInvalidState
+		notFound           *serviceerror.NotFound
+		invalidArgument    *serviceerror.InvalidArgument
+		permissionDenied   *serviceerror.PermissionDenied
+		failedPrecondition *serviceerror.FailedPrecondition
+		resourceExhausted  *serviceerror.ResourceExhausted
+		unavailable        *serviceerror.Unavailable
+		deadlineExceeded   *serviceerror.DeadlineExceeded
+		unimplemented      *serviceerror.Unimplemented
+	)
+
+	switch {
+	case errors.As(err, &namespaceNotFound):
+		return "The namespace does not exist. Check the namespace attribute of the resource, or the namespace of the provider, and that the namespace was not deleted outside of Terraform."
+	case errors.As(err, &namespaceNotActive):
+		return "The namespace is not active in this cluster. Point the provider to the cluster the namespace is active in."
+	case errors.As(err, &namespaceInvalid):
+		return "The namespace is in a state that does not allow this operation, e.g. it is deprecated or being deleted."
+	case errors.As(err, &notFound):
+		return "The object does not exist on the server. It may have been deleted outside of Terraform."
+	case isAlreadyExists(err):
+		return "The object already exists on the server. Import it into the Terraform state with `terraform import` instead of creating it."
+	case errors.As(err, &invalidArgument):
+		return "The server rejected the request as invalid. Check the values of the configuration."
+	case errors.As(err, &permissionDenied):
+		return "The credentials used by the provider are not allowed to perform this operation. Check the permissions of the API key or client certificate."
+	case errors.As(err, &failedPrecondition):
+		return "The server is not in a state that allows this operation. Check that the feature is enabled on the cluster."
+	case errors.As(err, &resourceExhausted):
+		return "The server is rate limiting the requests. Retry later, or reduce the parallelism with `terraform apply -parallelism=N`."
+	case errors.As(err, &unavailable):
+		return "The server is unavailable. Check the address of the provider and that the server is reachable, then retry."
+	case errors.As(err, &deadlineExceeded):
+		return "The request timed out. Check that the server is reachable and not overloaded, then retry."
+	case errors.As(err, &unimplemented):
+		return "The server does not support this operation. It may need to be upgraded, or the feature enabled."
+	case status.Code(err) == codes.Unauthenticated:
+		return "The provider could not authenticate. Check the API key or the TLS client certificate of the provider."
+	}
+	return ""
+}
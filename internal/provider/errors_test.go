@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"go.temporal.io/api/serviceerror"
+	sdktemporal "go.temporal.io/sdk/temporal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceErrors(t *testing.T) {
+	tests := []struct {
+		err           error
+		notFound      bool
+		alreadyExists bool
+		hint          string
+	}{
+		{err: serviceerror.NewNotFound("schedule not found"), notFound: true, hint: "deleted outside of Terraform"},
+		{err: serviceerror.NewNamespaceNotFound("example"), notFound: true, hint: "namespace does not exist"},
+		{err: fmt.Errorf("wrapped: %w", serviceerror.NewNotFound("gone")), notFound: true, hint: "deleted outside of Terraform"},
+		{err: serviceerror.NewNamespaceAlreadyExists("example"), alreadyExists: true, hint: "terraform import"},
+		{err: sdktemporal.ErrScheduleAlreadyRunning, alreadyExists: true, hint: "terraform import"},
+		{err: serviceerror.NewPermissionDenied("denied", ""), hint: "not allowed"},
+		{err: serviceerror.NewUnavailable("down"), hint: "unavailable"},
+		{err: serviceerror.FromStatus(status.New(codes.Unauthenticated, "no key")), hint: "authenticate"},
+		{err: errors.New("something else")},
+	}
+
+	for _, tt := range tests {
+		if got := isNotFound(tt.err); got != tt.notFound {
+			t.Errorf("isNotFound(%v) = %v, want %v", tt.err, got, tt.notFound)
+		}
+		if got := isAlreadyExists(tt.err); got != tt.alreadyExists {
+			t.Errorf("isAlreadyExists(%v) = %v, want %v", tt.err, got, tt.alreadyExists)
+		}
+		detail := serviceErrorDetail(tt.err)
+		if !strings.HasPrefix(detail, tt.err.Error()) || !strings.Contains(detail, tt.hint) {
+			t.Errorf("serviceErrorDetail(%v) = %q, want a hint containing %q", tt.err, detail, tt.hint)
+		}
+		if tt.hint == "" && detail != tt.err.Error() {
+			t.Errorf("serviceErrorDetail(%v) = %q, want no hint", tt.err, detail)
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporal "go.temporal.io/sdk/client"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &namespaceDataSource{}
+	_ datasource.DataSourceWithConfigure = &namespaceDataSource{}
+)
+
+func NewNamespaceDataSource() datasource.DataSource {
+	return &namespaceDataSource{}
+}
+
+type namespaceDataSource struct {
+	client temporal.Client
+}
+
+type namespaceDataSourceModel struct {
+	Name                    basetypes.StringValue `tfsdk:"name"`
+	ID                      basetypes.StringValue `tfsdk:"id"`
+	Description             basetypes.StringValue `tfsdk:"description"`
+	RetentionTTL            basetypes.StringValue `tfsdk:"retention_ttl"`
+	OwnerEmail              basetypes.StringValue `tfsdk:"owner_email"`
+	IsGlobal                basetypes.BoolValue   `tfsdk:"is_global"`
+	HistoryArchivalState    basetypes.StringValue `tfsdk:"history_archival_state"`
+	HistoryArchivalURI      basetypes.StringValue `tfsdk:"history_archival_uri"`
+	VisibilityArchivalState basetypes.StringValue `tfsdk:"visibility_archival_state"`
+	VisibilityArchivalURI   basetypes.StringValue `tfsdk:"visibility_archival_uri"`
+	Data                    map[string]string     `tfsdk:"data"`
+	DeletionProtection      basetypes.BoolValue   `tfsdk:"deletion_protection"`
+	State                   basetypes.StringValue `tfsdk:"state"`
+	ActiveClusterName       basetypes.StringValue `tfsdk:"active_cluster_name"`
+	Clusters                basetypes.ListValue   `tfsdk:"clusters"`
+	SearchAttributes        map[string]string     `tfsdk:"search_attributes"`
+}
+
+// parseNamespaceDataSource reads a namespace the same way as the resource,
+// along with the attributes only exposed by the data sources.
+func parseNamespaceDataSource(namespace namespaceResponse) *namespaceDataSourceModel {
+	ns := parseNamespaceResource(namespace)
+
+	return &namespaceDataSourceModel{
+		Name:                    ns.Name,
+		ID:                      ns.ID,
+		Description:             ns.Description,
+		RetentionTTL:            ns.RetentionTTL,
+		OwnerEmail:              ns.OwnerEmail,
+		IsGlobal:                ns.IsGlobal,
+		HistoryArchivalState:    ns.HistoryArchivalState,
+		HistoryArchivalURI:      ns.HistoryArchivalURI,
+		VisibilityArchivalState: ns.VisibilityArchivalState,
+		VisibilityArchivalURI:   ns.VisibilityArchivalURI,
+		Data:                    ns.Data,
+		DeletionProtection:      ns.DeletionProtection,
+		State:                   ns.State,
+		ActiveClusterName:       ns.ActiveClusterName,
+		Clusters:                ns.Clusters,
+	}
+}
+
+// namespaceSearchAttributes returns the custom search attributes registered on
+// a namespace, along with their type.
+func namespaceSearchAttributes(ctx context.Context, client temporal.Client, namespace string) (map[string]string, error) {
+	response, err := client.OperatorService().ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := make(map[string]string, len(response.GetCustomAttributes()))
+	for name, valueType := range response.GetCustomAttributes() {
+		attributes[name] = valueType.String()
+	}
+	return attributes, nil
+}
+
+// namespaceDataSourceAttributes returns the attributes describing a namespace,
+// shared by the namespace data sources.
+func namespaceDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Namespace ID.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the Namespace.",
+			Computed:    true,
+		},
+		"description": schema.StringAttribute{
+			Description: "Namespace description.",
+			Computed:    true,
+		},
+		"retention_ttl": schema.StringAttribute{
+			Description: "Workflow execution retention TTL. E.g \"24h\", \"365d\".",
+			Computed:    true,
+		},
+		"owner_email": schema.StringAttribute{
+			Description: "Namespace owner email address.",
+			Computed:    true,
+		},
+		"is_global": schema.BoolAttribute{
+			Description: "Whether that namespace is a global namespace.",
+			Computed:    true,
+		},
+		"history_archival_state": schema.StringAttribute{
+			MarkdownDescription: "History archival state, either `disabled` or `enabled`.",
+			Computed:            true,
+		},
+		"history_archival_uri": schema.StringAttribute{
+			MarkdownDescription: "History Archival URI.",
+			Computed:            true,
+		},
+		"visibility_archival_state": schema.StringAttribute{
+			MarkdownDescription: "Visibility archival state, either `disabled` or `enabled`.",
+			Computed:            true,
+		},
+		"visibility_archival_uri": schema.StringAttribute{
+			MarkdownDescription: "Visibility Archival URI.",
+			Computed:            true,
+		},
+		"data": schema.MapAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "Namespace data in key=value format.",
+		},
+		"deletion_protection": schema.BoolAttribute{
+			MarkdownDescription: "Whether the namespace is protected from deletion, as recorded in the `deletion-protection` key of the namespace data.",
+			Computed:            true,
+		},
+		"state": schema.StringAttribute{
+			MarkdownDescription: "State of the namespace, one of `registered`, `deprecated` or `deleted`.",
+			Computed:            true,
+		},
+		"active_cluster_name": schema.StringAttribute{
+			Description: "Name of the cluster the namespace is active in.",
+			Computed:    true,
+		},
+		"clusters": schema.ListAttribute{
+			ElementType: types.StringType,
+			Description: "Names of the clusters the namespace is replicated to.",
+			Computed:    true,
+		},
+		"search_attributes": schema.MapAttribute{
+			ElementType:         types.StringType,
+			MarkdownDescription: "Custom search attributes registered on the namespace, mapped to their type, e.g. `Keyword`.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *namespaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = cfg.client
+}
+
+// Metadata returns the data source type name.
+func (d *namespaceDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespace"
+}
+
+// Schema defines the schema for the data source.
+func (d *namespaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := namespaceDataSourceAttributes()
+	attributes["id"] = schema.StringAttribute{
+		MarkdownDescription: "Namespace ID. Exactly one of `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "Name of the Namespace. Exactly one of `id` or `name` must be set.",
+		Optional:            true,
+		Computed:            true,
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `temporal_namespace` data source allows you to read the settings of an existing namespace, looked up by name or ID.",
+		Attributes:          attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *namespaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config namespaceDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lookup := config.Name.ValueString()
+	if lookup == "" {
+		lookup = config.ID.ValueString()
+	}
+
+	namespace, err := d.client.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: config.Name.ValueString(),
+		Id:        config.ID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the Namespace "+lookup, serviceErrorDetail(err))
+		return
+	}
+
+	data := parseNamespaceDataSource(namespace)
+	data.SearchAttributes, err = namespaceSearchAttributes(ctx, d.client, data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the search attributes of namespace "+lookup, serviceErrorDetail(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
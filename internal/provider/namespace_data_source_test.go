@@ -0,0 +1,49 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNamespaceDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig + `
+resource "temporal_namespace" "example" {
+  name          = "example-data-source"
+  description   = "Example namespace"
+  retention_ttl = "3d"
+
+  deletion_protection = false
+}
+
+resource "temporal_search_attribute" "example" {
+  namespace = temporal_namespace.example.name
+  name      = "ExampleCustomerId"
+  type      = "Keyword"
+}
+
+data "temporal_namespace" "by_name" {
+  name = temporal_search_attribute.example.namespace
+}
+
+data "temporal_namespace" "by_id" {
+  id = data.temporal_namespace.by_name.id
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.temporal_namespace.by_name", "id", "temporal_namespace.example", "id"),
+					resource.TestCheckResourceAttr("data.temporal_namespace.by_name", "description", "Example namespace"),
+					resource.TestCheckResourceAttr("data.temporal_namespace.by_name", "retention_ttl", "3d"),
+					resource.TestCheckResourceAttr("data.temporal_namespace.by_name", "state", "registered"),
+					resource.TestCheckResourceAttr("data.temporal_namespace.by_name", "deletion_protection", "false"),
+					resource.TestCheckResourceAttr("data.temporal_namespace.by_name", "active_cluster_name", "active"),
+					resource.TestCheckResourceAttr("data.temporal_namespace.by_name", "search_attributes.ExampleCustomerId", "Keyword"),
+					resource.TestCheckResourceAttr("data.temporal_namespace.by_id", "name", "example-data-source"),
+				),
+			},
+		},
+	})
+}
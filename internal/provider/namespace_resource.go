@@ -3,11 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -17,17 +21,38 @@ import (
 	"go.temporal.io/api/enums/v1"
 	tpNamespace "go.temporal.io/api/namespace/v1"
 	"go.temporal.io/api/operatorservice/v1"
+	replicationpb "go.temporal.io/api/replication/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	temporal "go.temporal.io/sdk/client"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"strconv"
 	"strings"
 	"terraform-provider-temporal/internal/validators"
+	"time"
+)
+
+// Default timeouts of the namespace operations, when not set in the timeouts
+// block of the resource.
+const (
+	namespaceCreateTimeout = 2 * time.Minute
+	namespaceReadTimeout   = time.Minute
+	namespaceUpdateTimeout = 2 * time.Minute
+	namespaceDeleteTimeout = 10 * time.Minute
+)
+
+const (
+	// namespaceDeleteWaitTimeout bounds the wait for the server to finish
+	// deleting a namespace, when delete_timeout is not set.
+	namespaceDeleteWaitTimeout  = 5 * time.Minute
+	namespaceDeleteWaitInterval = time.Second
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &namespaceResource{}
-	_ resource.ResourceWithConfigure = &namespaceResource{}
+	_ resource.Resource                = &namespaceResource{}
+	_ resource.ResourceWithConfigure   = &namespaceResource{}
+	_ resource.ResourceWithImportState = &namespaceResource{}
+	_ resource.ResourceWithModifyPlan  = &namespaceResource{}
 )
 
 func NewNamespaceResource() resource.Resource {
@@ -45,11 +70,34 @@ type namespaceResourceModel struct {
 	RetentionTTL            basetypes.StringValue `tfsdk:"retention_ttl"`
 	OwnerEmail              basetypes.StringValue `tfsdk:"owner_email"`
 	IsGlobal                basetypes.BoolValue   `tfsdk:"is_global"`
+	State                   basetypes.StringValue `tfsdk:"state"`
+	ActiveClusterName       basetypes.StringValue `tfsdk:"active_cluster_name"`
+	Clusters                basetypes.ListValue   `tfsdk:"clusters"`
 	HistoryArchivalState    basetypes.StringValue `tfsdk:"history_archival_state"`
 	HistoryArchivalURI      basetypes.StringValue `tfsdk:"history_archival_uri"`
 	VisibilityArchivalState basetypes.StringValue `tfsdk:"visibility_archival_state"`
 	VisibilityArchivalURI   basetypes.StringValue `tfsdk:"visibility_archival_uri"`
 	Data                    map[string]string     `tfsdk:"data"`
+	DeletionProtection      basetypes.BoolValue   `tfsdk:"deletion_protection"`
+	DeleteTimeout           basetypes.StringValue `tfsdk:"delete_timeout"`
+	NamespaceDeleteDelay    basetypes.StringValue `tfsdk:"namespace_delete_delay"`
+	Timeouts                timeouts.Value        `tfsdk:"timeouts"`
+}
+
+// keepConfiguration copies from prior the attributes that only live in the
+// Terraform configuration, as the server does not return them.
+func (m *namespaceResourceModel) keepConfiguration(prior *namespaceResourceModel) {
+	m.DeleteTimeout = prior.DeleteTimeout
+	m.NamespaceDeleteDelay = prior.NamespaceDeleteDelay
+	m.Timeouts = prior.Timeouts
+}
+
+// namespaceStateValue converts a state accepted by the schema validator.
+func namespaceStateValue(str string) enums.NamespaceState {
+	if strings.ToLower(str) == "deprecated" {
+		return enums.NAMESPACE_STATE_DEPRECATED
+	}
+	return enums.NAMESPACE_STATE_REGISTERED
 }
 
 func archivalStateValue(diags diag.Diagnostics, str string) enums.ArchivalState {
@@ -73,6 +121,7 @@ type namespaceResponse interface {
 	GetNamespaceInfo() *tpNamespace.NamespaceInfo
 	GetConfig() *tpNamespace.NamespaceConfig
 	GetIsGlobalNamespace() bool
+	GetReplicationConfig() *replicationpb.NamespaceReplicationConfig
 }
 
 func parseNamespaceResource(namespace namespaceResponse) *namespaceResourceModel {
@@ -83,12 +132,81 @@ func parseNamespaceResource(namespace namespaceResponse) *namespaceResourceModel
 		RetentionTTL:            types.StringValue(formatDuration(namespace.GetConfig().WorkflowExecutionRetentionTtl.AsDuration())),
 		OwnerEmail:              types.StringValue(namespace.GetNamespaceInfo().OwnerEmail),
 		IsGlobal:                types.BoolValue(namespace.GetIsGlobalNamespace()),
+		State:                   parseNamespaceState(namespace.GetNamespaceInfo()),
+		ActiveClusterName:       types.StringValue(namespace.GetReplicationConfig().GetActiveClusterName()),
+		Clusters:                parseNamespaceClusters(namespace.GetReplicationConfig()),
 		HistoryArchivalState:    types.StringValue(strings.ToLower(namespace.GetConfig().HistoryArchivalState.String())),
 		HistoryArchivalURI:      types.StringValue(namespace.GetConfig().GetHistoryArchivalUri()),
 		VisibilityArchivalState: types.StringValue(strings.ToLower(namespace.GetConfig().VisibilityArchivalState.String())),
 		VisibilityArchivalURI:   types.StringValue(strings.ToLower(namespace.GetConfig().GetVisibilityArchivalUri())),
-		Data:                    namespace.GetNamespaceInfo().Data,
+		Data:                    namespaceUserData(namespace.GetNamespaceInfo().Data),
+		DeletionProtection:      types.BoolValue(namespace.GetNamespaceInfo().Data[namespaceDeletionProtectionKey] == "true"),
+	}
+}
+
+// parseNamespaceState returns the state of a namespace, e.g. "registered".
+func parseNamespaceState(info *tpNamespace.NamespaceInfo) basetypes.StringValue {
+	return types.StringValue(strings.ToLower(info.GetState().String()))
+}
+
+// parseNamespaceClusters returns the names of the clusters a namespace is
+// replicated to.
+func parseNamespaceClusters(config *replicationpb.NamespaceReplicationConfig) basetypes.ListValue {
+	clusters := make([]attr.Value, 0, len(config.GetClusters()))
+	for _, cluster := range config.GetClusters() {
+		clusters = append(clusters, types.StringValue(cluster.GetClusterName()))
+	}
+	return types.ListValueMust(types.StringType, clusters)
+}
+
+// namespaceDeletionProtectionKey is the key of the namespace data recording
+// whether the namespace is protected from deletion, so that tools other than
+// Terraform can honour it too.
+const namespaceDeletionProtectionKey = "deletion-protection"
+
+// namespaceUserData returns the namespace data without the keys managed by the
+// provider.
+func namespaceUserData(data map[string]string) map[string]string {
+	if _, ok := data[namespaceDeletionProtectionKey]; !ok {
+		return data
 	}
+	userData := make(map[string]string, len(data))
+	for k, v := range data {
+		if k != namespaceDeletionProtectionKey {
+			userData[k] = v
+		}
+	}
+	if len(userData) == 0 {
+		return nil
+	}
+	return userData
+}
+
+// clusterReplicationConfigs returns the configured clusters of the namespace,
+// or nil to leave them to the server.
+func (m *namespaceResourceModel) clusterReplicationConfigs(ctx context.Context) ([]*replicationpb.ClusterReplicationConfig, diag.Diagnostics) {
+	if m.Clusters.IsNull() || m.Clusters.IsUnknown() {
+		return nil, nil
+	}
+
+	var names []string
+	diags := m.Clusters.ElementsAs(ctx, &names, false)
+	clusters := make([]*replicationpb.ClusterReplicationConfig, 0, len(names))
+	for _, name := range names {
+		clusters = append(clusters, &replicationpb.ClusterReplicationConfig{ClusterName: name})
+	}
+	return clusters, diags
+}
+
+// namespaceData returns the namespace data to send to the server, recording
+// the deletion protection along with the configured data.
+func (m *namespaceResourceModel) namespaceData() map[string]string {
+	data := make(map[string]string, len(m.Data)+1)
+	for k, v := range m.Data {
+		data[k] = v
+	}
+	data[namespaceDeletionProtectionKey] = strconv.FormatBool(m.DeletionProtection.ValueBool())
+	return data
 }
 
 func (r *namespaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -117,7 +235,7 @@ func (r *namespaceResource) Metadata(_ context.Context, req resource.MetadataReq
 }
 
 // Schema defines the schema for the resource.
-func (r *namespaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *namespaceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The `temporal_namespace` resource allows you to create and manage namespaces within a Temporal server. A namespace in Temporal is a logical grouping of workflows, which helps in isolating and organizing workflows and activities.",
 		Attributes: map[string]schema.Attribute{
@@ -162,6 +280,40 @@ func (r *namespaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "State of the namespace. Accepted values: `registered`, `deprecated`. No new workflow can be started in a deprecated namespace, while the running ones carry on. A deprecated namespace cannot be registered again. Defaults to `registered`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("registered"),
+				Validators: []validator.String{
+					validators.StringInSliceValidator{
+						AllowedValues: []string{"registered", "deprecated"},
+					},
+				},
+			},
+			"active_cluster_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the cluster the namespace is active in. Changing it fails the namespace over to that cluster. Defaults to the current cluster.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"clusters": schema.ListAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "Names of the clusters the namespace is replicated to. Only global namespaces can be replicated to more than the current cluster. Defaults to the current cluster.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Whether the namespace is protected from deletion. While set, destroying the namespace fails, set it to `false` and apply first. Recorded in the `" + namespaceDeletionProtectionKey + "` key of the namespace data, so that other tools can honour it. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
 			"history_archival_state": schema.StringAttribute{
 				MarkdownDescription: "History archival state. Accepted values: `disabled`, `enabled`. History archival must be enabled at the cluster level first to be able to enable it for a namespace.",
 				Optional:            true,
@@ -206,6 +358,28 @@ func (r *namespaceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "Namespace data in key=value format.",
 				Default:     nil,
 			},
+			"delete_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait on destroy for the server to finish deleting the namespace, so that its name can be reused right away. E.g \"10m\". \"0s\" skips the wait. Defaults to `5m`, within the `delete` timeout of the resource.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.StringDurationValidator{},
+				},
+			},
+			"namespace_delete_delay": schema.StringAttribute{
+				MarkdownDescription: "Delay before the server removes the workflows of the namespace on destroy, e.g. \"7d\". The namespace is renamed right away, so its name can be reused. Defaults to the server setting.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.StringDurationValidator{},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -220,12 +394,19 @@ func (r *namespaceResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, namespaceCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	clusters, diags := data.clusterReplicationConfigs(ctx)
+	resp.Diagnostics.Append(diags...)
 	historyArchivalState := archivalStateValue(resp.Diagnostics, data.HistoryArchivalState.ValueString())
 	visibilityArchivalState := archivalStateValue(resp.Diagnostics, data.VisibilityArchivalState.ValueString())
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Create new namespace
 	ttl, _ := parseDuration(data.RetentionTTL.ValueString())
 	var _, err = r.client.WorkflowService().RegisterNamespace(ctx, &workflowservice.RegisterNamespaceRequest{
@@ -240,16 +421,32 @@ func (r *namespaceResource) Create(ctx context.Context, req resource.CreateReque
 		HistoryArchivalUri:      data.HistoryArchivalURI.ValueString(),
 		VisibilityArchivalState: visibilityArchivalState,
 		VisibilityArchivalUri:   data.VisibilityArchivalURI.ValueString(),
-		Data:                    data.Data, // map[string]string
+		Data:                    data.namespaceData(),
+		Clusters:                clusters,
+		ActiveClusterName:       data.ActiveClusterName.ValueString(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating namespace",
-			"Could not create namespace, unexpected error: "+err.Error(),
+			"Could not create namespace, unexpected error: "+serviceErrorDetail(err),
 		)
 		return
 	}
 
+	// Namespaces are always registered first.
+	if state := namespaceStateValue(data.State.ValueString()); state != enums.NAMESPACE_STATE_REGISTERED {
+		_, err = r.client.WorkflowService().UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+			Namespace: data.Name.ValueString(),
+			UpdateInfo: &tpNamespace.UpdateNamespaceInfo{
+				State: state,
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error deprecating namespace "+data.Name.ValueString(), serviceErrorDetail(err))
+			return
+		}
+	}
+
 	namespace, err := r.client.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
 		Namespace: data.Name.ValueString(),
 	})
@@ -257,7 +454,7 @@ func (r *namespaceResource) Create(ctx context.Context, req resource.CreateReque
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to find Temporal namespace after creation ",
-			err.Error(),
+			serviceErrorDetail(err),
 		)
 		return
 	}
@@ -269,9 +466,11 @@ func (r *namespaceResource) Create(ctx context.Context, req resource.CreateReque
 		resp.Diagnostics.AddError("Unable to enable visibility archival for the namespace. Is visibility archival enabled at the cluster level?", "")
 	}
 
+	planned := data
 	data = parseNamespaceResource(namespace)
+	data.keepConfiguration(planned)
 
-	diags := resp.State.Set(ctx, &data)
+	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -280,24 +479,41 @@ func (r *namespaceResource) Create(ctx context.Context, req resource.CreateReque
 
 // Read refreshes the Terraform state with the latest data.
 func (r *namespaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var id string
-	diags := req.State.GetAttribute(ctx, path.Root("id"), &id)
+	var state namespaceResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	id := state.ID.ValueString()
+
+	readTimeout, diags := state.Timeouts.Read(ctx, namespaceReadTimeout)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	namespace, err := r.client.WorkflowService().DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{Id: id})
 	if err != nil {
-		if err.Error() == "Namespace "+id+" is not found." {
+		if isNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error fetching the Namespace "+id, err.Error())
+			resp.Diagnostics.AddError("Error fetching the Namespace "+id, serviceErrorDetail(err))
 		}
 		return
 	}
 
+	// The namespace is being deleted outside of Terraform.
+	if namespace.GetNamespaceInfo().GetState() == enums.NAMESPACE_STATE_DELETED {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	data := parseNamespaceResource(namespace)
+	data.keepConfiguration(&state)
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -306,6 +522,31 @@ func (r *namespaceResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 }
 
+// ModifyPlan rejects the transitions of the namespace state that the server
+// refuses, so that they fail at plan time rather than halfway through apply.
+func (r *namespaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on creation or destruction.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var name, priorState, plannedState basetypes.StringValue
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("state"), &priorState)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("state"), &plannedState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if priorState.ValueString() == "deprecated" && !plannedState.IsUnknown() && plannedState.ValueString() != "deprecated" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("state"),
+			"Namespace "+name.ValueString()+" cannot be registered again",
+			"A deprecated namespace cannot go back to the registered state. Create a new namespace instead.",
+		)
+	}
+}
+
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *namespaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data *namespaceResourceModel
@@ -316,12 +557,50 @@ func (r *namespaceResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	var priorActiveClusterName, priorState basetypes.StringValue
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("active_cluster_name"), &priorActiveClusterName)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("state"), &priorState)...)
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, namespaceUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	clusters, diags := data.clusterReplicationConfigs(ctx)
+	resp.Diagnostics.Append(diags...)
 	historyArchivalState := archivalStateValue(resp.Diagnostics, data.HistoryArchivalState.ValueString())
 	visibilityArchivalState := archivalStateValue(resp.Diagnostics, data.VisibilityArchivalState.ValueString())
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Only send the state when it changes, as the server checks the transition.
+	var state enums.NamespaceState
+	if data.State.ValueString() != priorState.ValueString() {
+		state = namespaceStateValue(data.State.ValueString())
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// The server refuses a failover combined with other changes, fail over
+	// in a request of its own first.
+	activeClusterName := data.ActiveClusterName.ValueString()
+	if !data.ActiveClusterName.IsUnknown() && activeClusterName != priorActiveClusterName.ValueString() {
+		_, err := r.client.WorkflowService().UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
+			Namespace: data.Name.ValueString(),
+			ReplicationConfig: &replicationpb.NamespaceReplicationConfig{
+				ActiveClusterName: activeClusterName,
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error failing over namespace "+data.Name.ValueString()+" to cluster "+activeClusterName, serviceErrorDetail(err))
+			return
+		}
+	}
+
+	var replicationConfig *replicationpb.NamespaceReplicationConfig
+	if clusters != nil {
+		replicationConfig = &replicationpb.NamespaceReplicationConfig{Clusters: clusters}
+	}
+
 	ttl, _ := parseDuration(data.RetentionTTL.ValueString())
 
 	var ns, err = r.client.WorkflowService().UpdateNamespace(ctx, &workflowservice.UpdateNamespaceRequest{
@@ -329,7 +608,8 @@ func (r *namespaceResource) Update(ctx context.Context, req resource.UpdateReque
 		UpdateInfo: &tpNamespace.UpdateNamespaceInfo{
 			Description: data.Description.ValueString(),
 			OwnerEmail:  data.OwnerEmail.ValueString(),
-			Data:        data.Data,
+			Data:        data.namespaceData(),
+			State:       state,
 		},
 		Config: &tpNamespace.NamespaceConfig{
 			WorkflowExecutionRetentionTtl: &durationpb.Duration{Seconds: int64(ttl.Seconds())},
@@ -338,12 +618,13 @@ func (r *namespaceResource) Update(ctx context.Context, req resource.UpdateReque
 			VisibilityArchivalState:       visibilityArchivalState,
 			VisibilityArchivalUri:         data.VisibilityArchivalURI.ValueString(),
 		},
-		PromoteNamespace: data.IsGlobal.ValueBool(),
+		ReplicationConfig: replicationConfig,
+		PromoteNamespace:  data.IsGlobal.ValueBool(),
 	})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating namespace",
-			"Unexpected error: "+err.Error(),
+			"Unexpected error: "+serviceErrorDetail(err),
 		)
 		return
 	}
@@ -354,9 +635,11 @@ func (r *namespaceResource) Update(ctx context.Context, req resource.UpdateReque
 		resp.Diagnostics.AddError("Unable to enable visibility archival for the namespace. Is visibility archival enabled at the cluster level?", "")
 	}
 
+	planned := data
 	data = parseNamespaceResource(ns)
+	data.keepConfiguration(planned)
 
-	diags := resp.State.Set(ctx, &data)
+	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -374,16 +657,111 @@ func (r *namespaceResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Namespace "+data.Name.ValueString()+" is protected from deletion",
+			"Deleting the namespace would delete all of its workflows. Set deletion_protection to false and apply before destroying it.",
+		)
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, namespaceDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	waitTimeout := namespaceDeleteWaitTimeout
+	if !data.DeleteTimeout.IsNull() {
+		waitTimeout, _ = parseDuration(data.DeleteTimeout.ValueString())
+	}
+
+	var deleteDelay *durationpb.Duration
+	if !data.NamespaceDeleteDelay.IsNull() {
+		delay, _ := parseDuration(data.NamespaceDeleteDelay.ValueString())
+		deleteDelay = durationpb.New(delay)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	_, err := r.client.OperatorService().DeleteNamespace(ctx, &operatorservice.DeleteNamespaceRequest{
-		NamespaceId: data.ID.ValueString(),
-		Namespace:   data.Name.ValueString(),
+		NamespaceId:          data.ID.ValueString(),
+		Namespace:            data.Name.ValueString(),
+		NamespaceDeleteDelay: deleteDelay,
 	})
+	// The namespace is already gone, nothing to delete.
+	if isNotFound(err) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error while deleting namespace "+data.Name.ValueString(), err.Error())
+		resp.Diagnostics.AddError("Error while deleting namespace "+data.Name.ValueString(), serviceErrorDetail(err))
+		return
+	}
+
+	// The server only marks the namespace as deleted and renames it, the
+	// cleanup happens asynchronously. Wait for it so that a namespace with the
+	// same name can be created right after, for at most delete_timeout and
+	// within the delete timeout of the resource.
+	if waitTimeout <= 0 {
+		return
+	}
+	waitCtx, waitCancel := context.WithTimeout(ctx, waitTimeout)
+	defer waitCancel()
+	if err := waitNamespaceDeleted(waitCtx, r.client.WorkflowService(), data.Name.ValueString(), data.ID.ValueString()); err != nil {
+		resp.Diagnostics.AddError("Namespace "+data.Name.ValueString()+" was not deleted", serviceErrorDetail(err))
 		return
 	}
 }
 
+// waitNamespaceDeleted waits until the namespace name no longer resolves to
+// the namespace with the given ID, i.e. the namespace is gone or was renamed
+// by the deletion, or until the context is done.
+func waitNamespaceDeleted(ctx context.Context, service workflowservice.WorkflowServiceClient, name string, id string) error {
+	var lastErr error
+	for {
+		lastErr = namespaceDeleted(ctx, service, name, id)
+		if lastErr == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the deletion: %w", lastErr)
+		case <-time.After(namespaceDeleteWaitInterval):
+		}
+	}
+}
+
+func namespaceDeleted(ctx context.Context, service workflowservice.WorkflowServiceClient, name string, id string) error {
+	namespace, err := service.DescribeNamespace(ctx, &workflowservice.DescribeNamespaceRequest{
+		Namespace: name,
+	})
+	if isNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if namespace.GetNamespaceInfo().GetId() != id {
+		return nil
+	}
+	return fmt.Errorf("namespace %s still exists in state %s", name, strings.ToLower(namespace.GetNamespaceInfo().GetState().String()))
+}
+
+// ImportState imports a namespace either by its ID or by its name.
 func (r *namespaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	request := &workflowservice.DescribeNamespaceRequest{Namespace: req.ID}
+	if _, err := uuid.Parse(req.ID); err == nil {
+		request = &workflowservice.DescribeNamespaceRequest{Id: req.ID}
+	}
+
+	namespace, err := r.client.WorkflowService().DescribeNamespace(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing the Namespace "+req.ID, serviceErrorDetail(err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), namespace.GetNamespaceInfo().GetId())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), namespace.GetNamespaceInfo().GetName())...)
 }
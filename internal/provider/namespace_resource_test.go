@@ -0,0 +1,264 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.temporal.io/api/enums/v1"
+	tpNamespace "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func TestAccNamespaceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testProviderConfig + `
+resource "temporal_namespace" "example" {
+  name          = "example-namespace"
+  description   = "Example namespace"
+  retention_ttl = "3d"
+
+  deletion_protection = false
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_namespace.example", "name", "example-namespace"),
+					resource.TestCheckResourceAttr("temporal_namespace.example", "retention_ttl", "3d"),
+					resource.TestCheckResourceAttrSet("temporal_namespace.example", "id"),
+					resource.TestCheckResourceAttr("temporal_namespace.example", "state", "registered"),
+					resource.TestCheckResourceAttr("temporal_namespace.example", "active_cluster_name", "active"),
+					resource.TestCheckResourceAttr("temporal_namespace.example", "clusters.#", "1"),
+					resource.TestCheckResourceAttr("temporal_namespace.example", "clusters.0", "active"),
+				),
+			},
+			// ImportState testing - by name
+			{
+				ResourceName:      "temporal_namespace.example",
+				ImportState:       true,
+				ImportStateId:     "example-namespace",
+				ImportStateVerify: true,
+			},
+			// ImportState testing - by ID
+			{
+				ResourceName: "temporal_namespace.example",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["temporal_namespace.example"].Primary.ID, nil
+				},
+				ImportStateVerify: true,
+			},
+			// Update testing - deprecation
+			{
+				Config: testProviderConfig + `
+resource "temporal_namespace" "example" {
+  name          = "example-namespace"
+  description   = "Example namespace"
+  retention_ttl = "3d"
+  state         = "deprecated"
+
+  deletion_protection = false
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_namespace.example", "state", "deprecated"),
+				),
+			},
+			// Update testing - registering a deprecated namespace fails at plan time
+			{
+				Config: testProviderConfig + `
+resource "temporal_namespace" "example" {
+  name          = "example-namespace"
+  description   = "Example namespace"
+  retention_ttl = "3d"
+  state         = "registered"
+
+  deletion_protection = false
+}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("cannot be registered again"),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// deletingWorkflowService describes the namespace as being deleted until
+// remaining calls have been made, then as not found.
+type deletingWorkflowService struct {
+	workflowservice.UnimplementedWorkflowServiceServer
+
+	remaining int32
+	calls     atomic.Int32
+}
+
+func (s *deletingWorkflowService) DescribeNamespace(_ context.Context, req *workflowservice.DescribeNamespaceRequest) (*workflowservice.DescribeNamespaceResponse, error) {
+	if s.calls.Add(1) > s.remaining {
+		return nil, serviceerror.ToStatus(serviceerror.NewNamespaceNotFound(req.GetNamespace())).Err()
+	}
+	return &workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &tpNamespace.NamespaceInfo{
+			Name:  req.GetNamespace(),
+			Id:    "ns-id",
+			State: enums.NAMESPACE_STATE_DELETED,
+		},
+	}, nil
+}
+
+// serviceErrorInterceptor converts the gRPC errors into service errors, as the
+// SDK client does.
+func serviceErrorInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if err != nil {
+		return serviceerror.FromStatus(status.Convert(err))
+	}
+	return nil
+}
+
+func TestWaitNamespaceDeleted(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("waits until the namespace is gone", func(t *testing.T) {
+		service := &deletingWorkflowService{remaining: 1}
+		c := newTestWorkflowServiceClient(t, service, serviceErrorInterceptor)
+
+		if err := waitNamespaceDeleted(ctx, c, "test", "ns-id"); err != nil {
+			t.Fatal(err)
+		}
+		if got := service.calls.Load(); got != 2 {
+			t.Errorf("got %d calls, want 2", got)
+		}
+	})
+
+	t.Run("stops waiting once the name resolves to another namespace", func(t *testing.T) {
+		service := &deletingWorkflowService{remaining: 10}
+		c := newTestWorkflowServiceClient(t, service, serviceErrorInterceptor)
+
+		if err := waitNamespaceDeleted(ctx, c, "test", "previous-ns-id"); err != nil {
+			t.Fatal(err)
+		}
+		if got := service.calls.Load(); got != 1 {
+			t.Errorf("got %d calls, want 1", got)
+		}
+	})
+
+	t.Run("times out while the namespace still exists", func(t *testing.T) {
+		service := &deletingWorkflowService{remaining: 10}
+		c := newTestWorkflowServiceClient(t, service, serviceErrorInterceptor)
+
+		ctx, cancel := context.WithTimeout(ctx, 100*time.Millisecond)
+		defer cancel()
+
+		if err := waitNamespaceDeleted(ctx, c, "test", "ns-id"); err == nil {
+			t.Fatal("expected a timeout error")
+		}
+	})
+}
+
+func TestNamespaceModifyPlan(t *testing.T) {
+	ctx := context.Background()
+	r := &namespaceResource{}
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	nullValue := tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)
+
+	tests := []struct {
+		priorState   string
+		plannedState string
+		wantErr      bool
+	}{
+		{priorState: "registered", plannedState: "registered"},
+		{priorState: "registered", plannedState: "deprecated"},
+		{priorState: "deprecated", plannedState: "deprecated"},
+		{priorState: "deprecated", plannedState: "registered", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.priorState+" to "+tt.plannedState, func(t *testing.T) {
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: nullValue}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: nullValue}
+			var diags diag.Diagnostics
+			diags.Append(state.SetAttribute(ctx, path.Root("name"), "example")...)
+			diags.Append(state.SetAttribute(ctx, path.Root("state"), tt.priorState)...)
+			diags.Append(plan.SetAttribute(ctx, path.Root("name"), "example")...)
+			diags.Append(plan.SetAttribute(ctx, path.Root("state"), tt.plannedState)...)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			resp := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("got error %v, want %v (%v)", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// testResourceState returns the state of a resource with the given top-level
+// attributes set, and all the others null.
+func testResourceState(t *testing.T, r fwresource.Resource, attributes map[string]any) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	for name, value := range attributes {
+		if diags := state.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatal(diags)
+		}
+	}
+	return state
+}
+
+func TestParseNamespaceResourceDeletionProtection(t *testing.T) {
+	ns := parseNamespaceResource(&workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &tpNamespace.NamespaceInfo{
+			Name: "example",
+			Data: map[string]string{namespaceDeletionProtectionKey: "true", "team": "payments"},
+		},
+		Config: &tpNamespace.NamespaceConfig{},
+	})
+	if !ns.DeletionProtection.ValueBool() {
+		t.Error("got deletion protection disabled, want enabled from the namespace data")
+	}
+	if _, ok := ns.Data[namespaceDeletionProtectionKey]; ok || ns.Data["team"] != "payments" {
+		t.Errorf("got data %v, want only the user data", ns.Data)
+	}
+
+	ns = parseNamespaceResource(&workflowservice.DescribeNamespaceResponse{
+		NamespaceInfo: &tpNamespace.NamespaceInfo{Name: "example"},
+		Config:        &tpNamespace.NamespaceConfig{},
+	})
+	if ns.DeletionProtection.ValueBool() {
+		t.Error("got deletion protection enabled, want disabled without the data key")
+	}
+}
+
+func TestNamespaceDeleteProtected(t *testing.T) {
+	r := &namespaceResource{}
+	resp := &fwresource.DeleteResponse{}
+	r.Delete(context.Background(), fwresource.DeleteRequest{
+		State: testResourceState(t, r, map[string]any{"name": "example", "deletion_protection": true}),
+	}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error deleting a protected namespace")
+	}
+}
@@ -0,0 +1,187 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-temporal/internal/validators"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"go.temporal.io/api/enums/v1"
+	tpNamespace "go.temporal.io/api/namespace/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporal "go.temporal.io/sdk/client"
+)
+
+// namespacesPageSize is the number of namespaces fetched per ListNamespaces call.
+const namespacesPageSize = 100
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &namespacesDataSource{}
+	_ datasource.DataSourceWithConfigure = &namespacesDataSource{}
+)
+
+func NewNamespacesDataSource() datasource.DataSource {
+	return &namespacesDataSource{}
+}
+
+type namespacesDataSource struct {
+	client temporal.Client
+}
+
+type namespacesDataSourceModel struct {
+	NamePrefix basetypes.StringValue      `tfsdk:"name_prefix"`
+	NameRegex  basetypes.StringValue      `tfsdk:"name_regex"`
+	State      basetypes.StringValue      `tfsdk:"state"`
+	OwnerEmail basetypes.StringValue      `tfsdk:"owner_email"`
+	Namespaces []namespaceDataSourceModel `tfsdk:"namespaces"`
+}
+
+func (d *namespacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = cfg.client
+}
+
+// Metadata returns the data source type name.
+func (d *namespacesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_namespaces"
+}
+
+// Schema defines the schema for the data source.
+func (d *namespacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `temporal_namespaces` data source allows you to list the namespaces of a Temporal server, optionally filtered by name, state or owner.",
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				Description: "Only list the namespaces whose name starts with this prefix.",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list the namespaces whose name matches this regular expression. E.g `^team-a-.*$`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.StringRegexpValidator{},
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Only list the namespaces in this state. Accepted values: `registered`, `deprecated`, `deleted`. Deleted namespaces are only listed when filtering on the `deleted` state.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.StringInSliceValidator{
+						AllowedValues: []string{"registered", "deprecated", "deleted"},
+					},
+				},
+			},
+			"owner_email": schema.StringAttribute{
+				Description: "Only list the namespaces owned by this email address.",
+				Optional:    true,
+			},
+			"namespaces": schema.ListNestedAttribute{
+				Description: "Namespaces matching the filters, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: namespaceDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *namespacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data namespacesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if data.NameRegex.ValueString() != "" {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid name_regex", err.Error())
+			return
+		}
+	}
+
+	matches := func(namespace *workflowservice.DescribeNamespaceResponse) bool {
+		info := namespace.GetNamespaceInfo()
+		switch {
+		case !strings.HasPrefix(info.GetName(), data.NamePrefix.ValueString()):
+			return false
+		case nameRegex != nil && !nameRegex.MatchString(info.GetName()):
+			return false
+		case data.State.ValueString() != "" && strings.ToLower(info.GetState().String()) != data.State.ValueString():
+			return false
+		case data.OwnerEmail.ValueString() != "" && info.GetOwnerEmail() != data.OwnerEmail.ValueString():
+			return false
+		}
+		return true
+	}
+
+	data.Namespaces = make([]namespaceDataSourceModel, 0)
+	var nextPageToken []byte
+	for {
+		page, err := d.client.WorkflowService().ListNamespaces(ctx, &workflowservice.ListNamespacesRequest{
+			PageSize:      namespacesPageSize,
+			NextPageToken: nextPageToken,
+			NamespaceFilter: &tpNamespace.NamespaceFilter{
+				IncludeDeleted: data.State.ValueString() == "deleted",
+			},
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing the namespaces", serviceErrorDetail(err))
+			return
+		}
+
+		for _, namespace := range page.GetNamespaces() {
+			if !matches(namespace) {
+				continue
+			}
+			ns := parseNamespaceDataSource(namespace)
+			// Search attributes can't be listed on deleted namespaces.
+			if namespace.GetNamespaceInfo().GetState() != enums.NAMESPACE_STATE_DELETED {
+				ns.SearchAttributes, err = namespaceSearchAttributes(ctx, d.client, ns.Name.ValueString())
+				if err != nil {
+					resp.Diagnostics.AddError("Error fetching the search attributes of namespace "+ns.Name.ValueString(), serviceErrorDetail(err))
+					return
+				}
+			}
+			data.Namespaces = append(data.Namespaces, *ns)
+		}
+
+		nextPageToken = page.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	sort.Slice(data.Namespaces, func(i, j int) bool {
+		return data.Namespaces[i].Name.ValueString() < data.Namespaces[j].Name.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
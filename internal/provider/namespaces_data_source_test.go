@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNamespacesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig + `
+resource "temporal_namespace" "team_a" {
+  for_each    = toset(["team-a-orders", "team-a-billing"])
+  name        = each.key
+  owner_email = "team-a@example.com"
+
+  deletion_protection = false
+}
+
+resource "temporal_namespace" "team_b" {
+  name        = "team-b-orders"
+  owner_email = "team-b@example.com"
+
+  deletion_protection = false
+}
+
+data "temporal_namespaces" "team_a" {
+  name_prefix = "team-a-"
+  state       = "registered"
+  depends_on  = [temporal_namespace.team_a, temporal_namespace.team_b]
+}
+
+data "temporal_namespaces" "orders" {
+  name_regex  = "-orders$"
+  owner_email = "team-b@example.com"
+  depends_on  = [temporal_namespace.team_a, temporal_namespace.team_b]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporal_namespaces.team_a", "namespaces.#", "2"),
+					resource.TestCheckResourceAttr("data.temporal_namespaces.team_a", "namespaces.0.name", "team-a-billing"),
+					resource.TestCheckResourceAttr("data.temporal_namespaces.team_a", "namespaces.1.name", "team-a-orders"),
+					resource.TestCheckResourceAttr("data.temporal_namespaces.team_a", "namespaces.1.owner_email", "team-a@example.com"),
+					resource.TestCheckResourceAttr("data.temporal_namespaces.team_a", "namespaces.1.deletion_protection", "false"),
+					resource.TestCheckResourceAttr("data.temporal_namespaces.orders", "namespaces.#", "1"),
+					resource.TestCheckResourceAttrPair("data.temporal_namespaces.orders", "namespaces.0.id", "temporal_namespace.team_b", "id"),
+				),
+			},
+		},
+	})
+}
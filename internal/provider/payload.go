@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/sdk/converter"
+)
+
+// payloadEncodings lists the payload encodings that can be set on schedule inputs.
+var payloadEncodings = []string{
+	converter.MetadataEncodingJSON,
+	converter.MetadataEncodingBinary,
+	converter.MetadataEncodingProtoJSON,
+	converter.MetadataEncodingNil,
+}
+
+// jsonPayload builds an already encoded JSON payload, so that the data reads
+// back exactly as written.
+func jsonPayload(data string) *commonpb.Payload {
+	return &commonpb.Payload{
+		Metadata: map[string][]byte{converter.MetadataEncoding: []byte(converter.MetadataEncodingJSON)},
+		Data:     []byte(data),
+	}
+}
+
+// isJSONPayload reports whether the payload only carries plain JSON, and can
+// therefore be represented as a JSON string.
+func isJSONPayload(p *commonpb.Payload) bool {
+	return len(p.GetMetadata()) == 1 && string(p.GetMetadata()[converter.MetadataEncoding]) == converter.MetadataEncodingJSON
+}
+
+// payload encodes the payload model, setting the metadata explicitly rather
+// than relying on the SDK data converter.
+func (m schedulePayloadModel) payload() (*commonpb.Payload, error) {
+	encoding := m.Encoding.ValueString()
+	if m.Encoding.IsNull() || m.Encoding.IsUnknown() {
+		encoding = converter.MetadataEncodingJSON
+	}
+	data := m.Data.ValueString()
+
+	if encoding != converter.MetadataEncodingProtoJSON && !m.MessageType.IsNull() {
+		return nil, errors.New("message_type can only be set with the " + converter.MetadataEncodingProtoJSON + " encoding")
+	}
+
+	switch encoding {
+	case converter.MetadataEncodingJSON:
+		if !json.Valid([]byte(data)) {
+			return nil, errors.New("data must be a valid JSON string")
+		}
+		return jsonPayload(data), nil
+	case converter.MetadataEncodingProtoJSON:
+		if !json.Valid([]byte(data)) {
+			return nil, errors.New("data must be a valid JSON string")
+		}
+		if m.MessageType.ValueString() == "" {
+			return nil, errors.New("message_type is required with the " + converter.MetadataEncodingProtoJSON + " encoding")
+		}
+		return &commonpb.Payload{
+			Metadata: map[string][]byte{
+				converter.MetadataEncoding:    []byte(encoding),
+				converter.MetadataMessageType: []byte(m.MessageType.ValueString()),
+			},
+			Data: []byte(data),
+		}, nil
+	case converter.MetadataEncodingBinary:
+		raw, err := base64.StdEncoding.DecodeString(data)
+		if err != nil {
+			return nil, errors.New("data must be base64 encoded with the " + converter.MetadataEncodingBinary + " encoding")
+		}
+		return &commonpb.Payload{
+			Metadata: map[string][]byte{converter.MetadataEncoding: []byte(encoding)},
+			Data:     raw,
+		}, nil
+	case converter.MetadataEncodingNil:
+		if data != "" {
+			return nil, errors.New("data must be empty with the " + converter.MetadataEncodingNil + " encoding")
+		}
+		return &commonpb.Payload{
+			Metadata: map[string][]byte{converter.MetadataEncoding: []byte(encoding)},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported encoding %q", encoding)
+	}
+}
+
+// parsePayload decodes a payload the same way it is encoded by payload.
+func parsePayload(p *commonpb.Payload) schedulePayloadModel {
+	encoding := string(p.GetMetadata()[converter.MetadataEncoding])
+	m := schedulePayloadModel{
+		Data:        types.StringValue(string(p.GetData())),
+		Encoding:    types.StringValue(encoding),
+		MessageType: types.StringNull(),
+	}
+
+	switch encoding {
+	case converter.MetadataEncodingBinary:
+		m.Data = types.StringValue(base64.StdEncoding.EncodeToString(p.GetData()))
+	case converter.MetadataEncodingNil:
+		m.Data = types.StringNull()
+	case converter.MetadataEncodingProtoJSON:
+		m.MessageType = types.StringValue(string(p.GetMetadata()[converter.MetadataMessageType]))
+	}
+
+	return m
+}
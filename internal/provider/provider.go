@@ -4,16 +4,24 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 
+	"terraform-provider-temporal/internal/validators"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -26,17 +34,65 @@ var (
 	_ provider.Provider = &temporalProvider{}
 )
 
+// defaultRPCTimeout is the deadline of each call to the Temporal server when
+// rpc_timeout is not set.
+const defaultRPCTimeout = 30 * time.Second
+
 // temporalProviderModel maps provider schema data to a Go type.
 type temporalProviderModel struct {
-	Address   types.String `tfsdk:"address"`
-	Namespace types.String `tfsdk:"namespace"`
-	TLS       types.Bool   `tfsdk:"tls"`
-	APIKey    types.String `tfsdk:"api_key"`
+	Address               types.String `tfsdk:"address"`
+	Namespace             types.String `tfsdk:"namespace"`
+	TLS                   types.Bool   `tfsdk:"tls"`
+	TLSCert               types.String `tfsdk:"tls_cert"`
+	TLSKey                types.String `tfsdk:"tls_key"`
+	TLSCACert             types.String `tfsdk:"tls_ca_cert"`
+	TLSServerName         types.String `tfsdk:"tls_server_name"`
+	TLSInsecureSkipVerify types.Bool   `tfsdk:"tls_insecure_skip_verify"`
+	APIKey                types.String `tfsdk:"api_key"`
+	RPCTimeout            types.String `tfsdk:"rpc_timeout"`
+	Codec                 *codecModel  `tfsdk:"codec"`
+	Retry                 *retryModel  `tfsdk:"retry"`
+}
+
+type codecModel struct {
+	Endpoint   types.String      `tfsdk:"endpoint"`
+	Headers    map[string]string `tfsdk:"headers"`
+	AESKeyEnv  types.String      `tfsdk:"aes_key_env"`
+	AESKeyFile types.String      `tfsdk:"aes_key_file"`
+	AESKeyID   types.String      `tfsdk:"aes_key_id"`
 }
 
 type providerConfig struct {
 	client    client.Client
 	namespace string
+	codec     *codecConfig
+
+	mu      sync.Mutex
+	clients map[string]client.Client
+}
+
+// namespaceClient returns a client bound to the given namespace. Clients share
+// the connection of the provider client and are cached per namespace.
+func (c *providerConfig) namespaceClient(namespace string) (client.Client, error) {
+	if namespace == "" || namespace == c.namespace {
+		return c.client, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if nsClient, ok := c.clients[namespace]; ok {
+		return nsClient, nil
+	}
+	nsClient, err := client.NewClientFromExisting(c.client, client.Options{Namespace: namespace})
+	if err != nil {
+		return nil, err
+	}
+	if c.clients == nil {
+		c.clients = make(map[string]client.Client)
+	}
+	c.clients[namespace] = nsClient
+	return nsClient, nil
 }
 
 // New is a helper function to simplify provider server and testing implementation.
@@ -74,13 +130,96 @@ func (p *temporalProvider) Schema(_ context.Context, _ provider.SchemaRequest, r
 			},
 			"tls": schema.BoolAttribute{
 				Optional:            true,
-				MarkdownDescription: "Whether to use TLS for the Temporal server connection. Defaults to `false`.",
+				MarkdownDescription: "Whether to use TLS for the Temporal server connection. Defaults to `false`, unless one of the other `tls_*` attributes is set. Setting it to `false` together with any of them is an error.",
+			},
+			"tls_cert": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Client certificate used for mutual TLS, either as PEM content or as a path to a PEM file. Can also be set via the `TEMPORAL_TLS_CERT` environment variable. Must be set together with `tls_key`.",
+			},
+			"tls_key": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Client private key used for mutual TLS, either as PEM content or as a path to a PEM file. Can also be set via the `TEMPORAL_TLS_KEY` environment variable. Must be set together with `tls_cert`.",
+			},
+			"tls_ca_cert": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "CA certificate used to verify the Temporal server, either as PEM content or as a path to a PEM file. Can also be set via the `TEMPORAL_TLS_CA` environment variable. Defaults to the system CA pool.",
+			},
+			"tls_server_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Server name used to verify the Temporal server certificate. Defaults to the host part of `address`.",
+			},
+			"tls_insecure_skip_verify": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to skip the verification of the Temporal server certificate. Only use this for testing. Defaults to `false`.",
 			},
 			"api_key": schema.StringAttribute{
 				Optional:            true,
 				Sensitive:           true,
 				MarkdownDescription: "API key for Temporal Cloud authentication. Can also be set via the `TEMPORAL_API_KEY` environment variable.",
 			},
+			"rpc_timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Deadline of each call to the Temporal server, e.g. `30s`. It bounds every single call, including each retry and each poll while waiting on the server, but not a whole operation: the `timeouts` of a resource do. Defaults to `30s`.",
+				Validators: []validator.String{
+					validators.StringDurationValidator{},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"codec": schema.SingleNestedBlock{
+				MarkdownDescription: "Payload codec applied to schedule inputs, e.g. to encrypt them the same way as workers do. Either a remote codec server `endpoint` or a built-in AES-GCM codec with a key from `aes_key_env` or `aes_key_file`.",
+				Attributes: map[string]schema.Attribute{
+					"endpoint": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "URL of a remote codec server, exposing the `/encode` and `/decode` endpoints. The namespace is sent in the `X-Namespace` header.",
+					},
+					"headers": schema.MapAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						Sensitive:           true,
+						MarkdownDescription: "HTTP headers sent to the remote codec server, e.g. `Authorization`.",
+					},
+					"aes_key_env": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Name of the environment variable holding the AES-GCM key. The key must be 16, 24 or 32 bytes long, either raw or base64 encoded.",
+					},
+					"aes_key_file": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Path to a file holding the AES-GCM key. The key must be 16, 24 or 32 bytes long, either raw or base64 encoded.",
+					},
+					"aes_key_id": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "ID of the AES-GCM key, recorded in the `encryption-key-id` payload metadata. Defaults to `default`.",
+					},
+				},
+			},
+			"retry": schema.SingleNestedBlock{
+				MarkdownDescription: "Retry policy of the calls to the Temporal server failing with a transient error, e.g. when the server is unavailable or rate limiting. Only the read-only calls and the deletions are retried.",
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum number of attempts of a call, including the first one. Set it to `1` to disable the retries. Defaults to `5`.",
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"initial_backoff": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Delay before the first retry, doubled on each following retry, e.g. `1s`. Each delay is randomly shortened by up to half to spread the retries. Defaults to `1s`.",
+						Validators: []validator.String{
+							validators.StringDurationValidator{},
+						},
+					},
+					"max_backoff": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "Maximum delay between two retries, e.g. `1m`. Defaults to `30s`.",
+						Validators: []validator.String{
+							validators.StringDurationValidator{},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -102,12 +241,13 @@ func (p *temporalProvider) Configure(ctx context.Context, req provider.Configure
 		address = config.Address.ValueString()
 	}
 
-	tlsEnabled := false
+	tlsEnabled, tlsSet := false, false
 	if !config.TLS.IsNull() {
-		tlsEnabled = config.TLS.ValueBool()
+		tlsEnabled, tlsSet = config.TLS.ValueBool(), true
 	} else if os.Getenv("TLS") != "" {
 		var err error
 		tlsEnabled, err = strconv.ParseBool(os.Getenv("TLS"))
+		tlsSet = true
 		if err != nil {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("tls"),
@@ -118,6 +258,46 @@ func (p *temporalProvider) Configure(ctx context.Context, req provider.Configure
 		}
 	}
 
+	tlsCert := os.Getenv("TEMPORAL_TLS_CERT")
+	if !config.TLSCert.IsNull() {
+		tlsCert = config.TLSCert.ValueString()
+	}
+
+	tlsKey := os.Getenv("TEMPORAL_TLS_KEY")
+	if !config.TLSKey.IsNull() {
+		tlsKey = config.TLSKey.ValueString()
+	}
+
+	tlsCACert := os.Getenv("TEMPORAL_TLS_CA")
+	if !config.TLSCACert.IsNull() {
+		tlsCACert = config.TLSCACert.ValueString()
+	}
+
+	if (tlsCert == "") != (tlsKey == "") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tls_cert"),
+			"Incomplete TLS client certificate configuration",
+			"Both tls_cert and tls_key (or the TEMPORAL_TLS_CERT and TEMPORAL_TLS_KEY environment variables) must be set to use mutual TLS.",
+		)
+		return
+	}
+
+	// Any of the TLS specific settings implies TLS, unless TLS is explicitly
+	// disabled, in which case they would be silently ignored.
+	if tlsCert != "" || tlsCACert != "" || config.TLSServerName.ValueString() != "" || config.TLSInsecureSkipVerify.ValueBool() {
+		if tlsSet && !tlsEnabled {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("tls"),
+				"Conflicting TLS configuration",
+				"TLS is disabled, but some of tls_cert, tls_key, tls_ca_cert, tls_server_name or tls_insecure_skip_verify "+
+					"(or the TEMPORAL_TLS_CERT, TEMPORAL_TLS_KEY and TEMPORAL_TLS_CA environment variables) are set. "+
+					"Either enable TLS or unset them.",
+			)
+			return
+		}
+		tlsEnabled = true
+	}
+
 	namespace := "default"
 	if !config.Namespace.IsNull() {
 		namespace = config.Namespace.ValueString()
@@ -127,7 +307,13 @@ func (p *temporalProvider) Configure(ctx context.Context, req provider.Configure
 
 	interceptors := []grpc.UnaryClientInterceptor{
 		func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
-			ctx = metadata.AppendToOutgoingContext(ctx, "temporal-namespace", namespace)
+			// Route the call to the namespace of the request when it has one,
+			// as resources may operate outside of the provider namespace.
+			headerNamespace := namespace
+			if r, ok := req.(interface{ GetNamespace() string }); ok && r.GetNamespace() != "" {
+				headerNamespace = r.GetNamespace()
+			}
+			ctx = metadata.AppendToOutgoingContext(ctx, "temporal-namespace", headerNamespace)
 			return invoker(ctx, method, req, reply, cc, opts...)
 		},
 	}
@@ -158,6 +344,36 @@ func (p *temporalProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
+	codec, err := buildCodecConfig(config.Codec)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("codec"), "Invalid payload codec configuration", err.Error())
+		return
+	}
+
+	retry, err := buildRetryConfig(config.Retry)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("retry"), "Invalid retry configuration", err.Error())
+		return
+	}
+	interceptors = append(interceptors, retry.interceptor())
+
+	rpcTimeout := defaultRPCTimeout
+	if !config.RPCTimeout.IsNull() {
+		rpcTimeout, err = parseDuration(config.RPCTimeout.ValueString())
+		if err != nil || rpcTimeout <= 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("rpc_timeout"), "Invalid RPC timeout", "The RPC timeout must be a positive duration, e.g. \"30s\".")
+			return
+		}
+	}
+	// Bound each call, and each of its retries and polls, unless the context
+	// of the operation expires sooner. The operation as a whole is bounded by
+	// the timeouts of the resource.
+	interceptors = append(interceptors, func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, cancel := context.WithTimeout(ctx, rpcTimeout)
+		defer cancel()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	})
+
 	clientOptions := client.Options{
 		HostPort:  address,
 		Namespace: namespace,
@@ -168,14 +384,14 @@ func (p *temporalProvider) Configure(ctx context.Context, req provider.Configure
 
 	// Add TLS if enabled or if API key is provided (API key requires TLS for security)
 	if tlsEnabled {
-		pool, err := x509.SystemCertPool()
+		tlsConfig, err := buildTLSConfig(tlsCert, tlsKey, tlsCACert)
 		if err != nil {
-			resp.Diagnostics.AddError("Couldn't load the system CA certificate pool", err.Error())
+			resp.Diagnostics.AddError("Couldn't build the TLS configuration", err.Error())
 			return
 		}
-		creds := credentials.NewTLS(&tls.Config{
-			RootCAs: pool,
-		})
+		tlsConfig.ServerName = config.TLSServerName.ValueString()
+		tlsConfig.InsecureSkipVerify = config.TLSInsecureSkipVerify.ValueBool()
+		creds := credentials.NewTLS(tlsConfig)
 		dialOptions = append(dialOptions, grpc.WithTransportCredentials(creds))
 	}
 
@@ -193,21 +409,135 @@ func (p *temporalProvider) Configure(ctx context.Context, req provider.Configure
 	cfg := &providerConfig{
 		client:    temporalClient,
 		namespace: namespace,
+		codec:     codec,
 	}
 
 	resp.DataSourceData = cfg
 	resp.ResourceData = cfg
 }
 
+// buildTLSConfig loads the CA pool and the optional client certificate. Each
+// value can either be PEM content or a path to a PEM file.
+func buildTLSConfig(cert, key, caCert string) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if caCert != "" {
+		caPEM, err := readPEM(caCert)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, errors.New("no valid certificate found in the CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	} else {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, fmt.Errorf("loading the system CA certificate pool: %w", err)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if cert != "" {
+		certPEM, err := readPEM(cert)
+		if err != nil {
+			return nil, fmt.Errorf("reading client certificate: %w", err)
+		}
+		keyPEM, err := readPEM(key)
+		if err != nil {
+			return nil, fmt.Errorf("reading client key: %w", err)
+		}
+		keyPair, err := tls.X509KeyPair(certPEM, keyPEM)
+		if err != nil {
+			return nil, fmt.Errorf("loading client key pair: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{keyPair}
+	}
+
+	return tlsConfig, nil
+}
+
+// buildCodecConfig validates the codec configuration and loads the AES key.
+func buildCodecConfig(m *codecModel) (*codecConfig, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	sources := 0
+	for _, v := range []types.String{m.Endpoint, m.AESKeyEnv, m.AESKeyFile} {
+		if v.ValueString() != "" {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return nil, errors.New("exactly one of endpoint, aes_key_env or aes_key_file must be set")
+	}
+
+	if m.Endpoint.ValueString() != "" {
+		return &codecConfig{
+			endpoint: strings.TrimSuffix(m.Endpoint.ValueString(), "/"),
+			headers:  m.Headers,
+		}, nil
+	}
+
+	var key []byte
+	if m.AESKeyEnv.ValueString() != "" {
+		key = []byte(os.Getenv(m.AESKeyEnv.ValueString()))
+		if len(key) == 0 {
+			return nil, fmt.Errorf("the %s environment variable is empty", m.AESKeyEnv.ValueString())
+		}
+	} else {
+		var err error
+		key, err = os.ReadFile(m.AESKeyFile.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("reading the AES key: %w", err)
+		}
+	}
+	key, err := parseAESKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	keyID := "default"
+	if m.AESKeyID.ValueString() != "" {
+		keyID = m.AESKeyID.ValueString()
+	}
+	aesCodec, err := newAESGCMCodec(keyID, key)
+	if err != nil {
+		return nil, err
+	}
+	return &codecConfig{aes: aesCodec}, nil
+}
+
+// readPEM returns the given value as is when it contains PEM data, and reads
+// it as a file path otherwise.
+func readPEM(value string) ([]byte, error) {
+	if strings.Contains(value, "-----BEGIN ") {
+		return []byte(value), nil
+	}
+	return os.ReadFile(value)
+}
+
 // DataSources defines the data sources implemented in the provider.
 func (p *temporalProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewClusterDataSource,
+		NewNamespaceDataSource,
+		NewNamespacesDataSource,
+		NewScheduleDataSource,
+		NewSchedulesDataSource,
+	}
 }
 
 // Resources defines the resources implemented in the provider.
 func (p *temporalProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewNamespaceResource,
+		NewRemoteClusterResource,
 		NewScheduleResource,
+		NewSearchAttributeResource,
 	}
 }
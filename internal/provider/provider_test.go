@@ -1,6 +1,18 @@
 package provider
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
@@ -19,3 +31,113 @@ var (
 		"temporal": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+// testCertificate returns a self-signed certificate and its private key, both
+// PEM encoded.
+func testCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestReadPEM(t *testing.T) {
+	cert, _ := testCertificate(t)
+	file := filepath.Join(t.TempDir(), "cert.pem")
+	if err := os.WriteFile(file, []byte(cert), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{name: "PEM content", value: cert, want: cert},
+		{name: "path to a PEM file", value: file, want: cert},
+		{name: "missing file", value: filepath.Join(t.TempDir(), "missing.pem"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := readPEM(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("readPEM() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("readPEM() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildTLSConfig(t *testing.T) {
+	cert, key := testCertificate(t)
+	_, otherKey := testCertificate(t)
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	if err := os.WriteFile(certFile, []byte(cert), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, []byte(key), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name             string
+		cert, key, ca    string
+		wantCertificates int
+		wantErr          bool
+	}{
+		{name: "system CA pool"},
+		{name: "CA as PEM content", ca: cert},
+		{name: "CA as a path", ca: certFile},
+		{name: "client certificate as PEM content", cert: cert, key: key, ca: cert, wantCertificates: 1},
+		{name: "client certificate as paths", cert: certFile, key: keyFile, wantCertificates: 1},
+		{name: "client certificate without key", cert: cert, wantErr: true},
+		{name: "mismatching client key", cert: cert, key: otherKey, wantErr: true},
+		{name: "CA without certificate", ca: key, wantErr: true},
+		{name: "missing CA file", ca: filepath.Join(dir, "missing.pem"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, err := buildTLSConfig(tt.cert, tt.key, tt.ca)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildTLSConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if cfg.RootCAs == nil {
+				t.Error("expected a CA pool")
+			}
+			if len(cfg.Certificates) != tt.wantCertificates {
+				t.Errorf("got %d client certificates, want %d", len(cfg.Certificates), tt.wantCertificates)
+			}
+		})
+	}
+}
@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporal "go.temporal.io/sdk/client"
+)
+
+// remoteClustersPageSize is the number of clusters fetched per ListClusters call.
+const remoteClustersPageSize = 100
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &remoteClusterResource{}
+	_ resource.ResourceWithConfigure   = &remoteClusterResource{}
+	_ resource.ResourceWithImportState = &remoteClusterResource{}
+)
+
+func NewRemoteClusterResource() resource.Resource {
+	return &remoteClusterResource{}
+}
+
+type remoteClusterResource struct {
+	client temporal.Client
+}
+
+type remoteClusterResourceModel struct {
+	FrontendAddress               basetypes.StringValue `tfsdk:"frontend_address"`
+	EnableRemoteClusterConnection basetypes.BoolValue   `tfsdk:"enable_remote_cluster_connection"`
+	ClusterName                   basetypes.StringValue `tfsdk:"cluster_name"`
+	ClusterID                     basetypes.StringValue `tfsdk:"cluster_id"`
+	HistoryShardCount             basetypes.Int64Value  `tfsdk:"history_shard_count"`
+}
+
+func parseRemoteClusterResource(cluster *operatorservice.ClusterMetadata) *remoteClusterResourceModel {
+	return &remoteClusterResourceModel{
+		FrontendAddress:               types.StringValue(cluster.GetAddress()),
+		EnableRemoteClusterConnection: types.BoolValue(cluster.GetIsConnectionEnabled()),
+		ClusterName:                   types.StringValue(cluster.GetClusterName()),
+		ClusterID:                     types.StringValue(cluster.GetClusterId()),
+		HistoryShardCount:             types.Int64Value(int64(cluster.GetHistoryShardCount())),
+	}
+}
+
+func (r *remoteClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = cfg.client
+}
+
+// Metadata returns the resource type name.
+func (r *remoteClusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_cluster"
+}
+
+// Schema defines the schema for the resource.
+func (r *remoteClusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `temporal_remote_cluster` resource allows you to connect the Temporal cluster the provider is connected to with a remote cluster, e.g. to replicate global namespaces across them. The connection must be declared on both clusters.",
+		Attributes: map[string]schema.Attribute{
+			"frontend_address": schema.StringAttribute{
+				MarkdownDescription: "Frontend gRPC address of the remote cluster, reachable from this cluster. Of the form `host:port`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enable_remote_cluster_connection": schema.BoolAttribute{
+				MarkdownDescription: "Whether the connection to the remote cluster is enabled. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"cluster_name": schema.StringAttribute{
+				Description: "Name of the remote cluster.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "ID of the remote cluster.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"history_shard_count": schema.Int64Attribute{
+				Description: "Number of history shards of the remote cluster.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// listClusters returns the clusters known to the server, including the
+// cluster the provider is connected to.
+func (r *remoteClusterResource) listClusters(ctx context.Context) ([]*operatorservice.ClusterMetadata, error) {
+	var clusters []*operatorservice.ClusterMetadata
+	var nextPageToken []byte
+	for {
+		page, err := r.client.OperatorService().ListClusters(ctx, &operatorservice.ListClustersRequest{
+			PageSize:      remoteClustersPageSize,
+			NextPageToken: nextPageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		clusters = append(clusters, page.GetClusters()...)
+
+		nextPageToken = page.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			return clusters, nil
+		}
+	}
+}
+
+// findCluster returns the remote cluster with the given name or, when the name
+// is empty, the given address. The cluster the provider is connected to is
+// never returned. It returns nil when there is no such cluster.
+func (r *remoteClusterResource) findCluster(ctx context.Context, name string, address string) (*operatorservice.ClusterMetadata, error) {
+	localCluster, err := r.client.WorkflowService().GetClusterInfo(ctx, &workflowservice.GetClusterInfoRequest{})
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := r.listClusters(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cluster := range clusters {
+		if cluster.GetClusterName() == localCluster.GetClusterName() {
+			continue
+		}
+		if (name != "" && cluster.GetClusterName() == name) || (name == "" && cluster.GetAddress() == address) {
+			return cluster, nil
+		}
+	}
+	return nil, nil
+}
+
+// addOrUpdate registers the remote cluster and returns it as listed by the
+// server, along with whether the registration went through. A new cluster is
+// told apart by its name not being listed before the call, as the server may
+// list it with a normalized address.
+func (r *remoteClusterResource) addOrUpdate(ctx context.Context, data *remoteClusterResourceModel) (*operatorservice.ClusterMetadata, bool, error) {
+	name := data.ClusterName.ValueString()
+	var before []*operatorservice.ClusterMetadata
+	if name == "" {
+		var err error
+		if before, err = r.listClusters(ctx); err != nil {
+			return nil, false, err
+		}
+	}
+
+	_, err := r.client.OperatorService().AddOrUpdateRemoteCluster(ctx, &operatorservice.AddOrUpdateRemoteClusterRequest{
+		FrontendAddress:               data.FrontendAddress.ValueString(),
+		EnableRemoteClusterConnection: data.EnableRemoteClusterConnection.ValueBool(),
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if name == "" {
+		after, err := r.listClusters(ctx)
+		if err != nil {
+			return nil, true, err
+		}
+		if added := addedClusters(before, after); len(added) == 1 {
+			name = added[0].GetClusterName()
+		}
+	}
+
+	cluster, err := r.findCluster(ctx, name, data.FrontendAddress.ValueString())
+	if err != nil {
+		return nil, true, err
+	}
+	if cluster == nil {
+		return nil, true, fmt.Errorf("the remote cluster with address %s is not listed by the server", data.FrontendAddress.ValueString())
+	}
+	return cluster, true, nil
+}
+
+// addedClusters returns the clusters of after whose name is not in before.
+func addedClusters(before, after []*operatorservice.ClusterMetadata) []*operatorservice.ClusterMetadata {
+	known := make(map[string]bool, len(before))
+	for _, cluster := range before {
+		known[cluster.GetClusterName()] = true
+	}
+	var added []*operatorservice.ClusterMetadata
+	for _, cluster := range after {
+		if !known[cluster.GetClusterName()] {
+			added = append(added, cluster)
+		}
+	}
+	return added
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *remoteClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *remoteClusterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, added, err := r.addOrUpdate(ctx, data)
+	if err != nil {
+		// Keep the cluster in the state once added, so that it is not left
+		// behind. Its computed attributes are filled in on the next refresh.
+		if added {
+			data.ClusterName = types.StringNull()
+			data.ClusterID = types.StringNull()
+			data.HistoryShardCount = types.Int64Null()
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		}
+		resp.Diagnostics.AddError("Error adding the remote cluster "+data.FrontendAddress.ValueString(), serviceErrorDetail(err))
+		return
+	}
+
+	data = parseRemoteClusterResource(cluster)
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *remoteClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var name, address basetypes.StringValue
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("cluster_name"), &name)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("frontend_address"), &address)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The name is only missing when the cluster could not be found right
+	// after being added, look it up by address instead.
+	cluster, err := r.findCluster(ctx, name.ValueString(), address.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the remote cluster "+address.ValueString(), serviceErrorDetail(err))
+		return
+	}
+	if cluster == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data := parseRemoteClusterResource(cluster)
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *remoteClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data *remoteClusterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cluster, _, err := r.addOrUpdate(ctx, data)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating the remote cluster "+data.FrontendAddress.ValueString(), serviceErrorDetail(err))
+		return
+	}
+
+	data = parseRemoteClusterResource(cluster)
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *remoteClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data remoteClusterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.ClusterName.ValueString()
+	if name == "" {
+		cluster, err := r.findCluster(ctx, "", data.FrontendAddress.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error fetching the remote cluster "+data.FrontendAddress.ValueString(), serviceErrorDetail(err))
+			return
+		}
+		// The remote cluster is already gone, nothing to delete.
+		if cluster == nil {
+			return
+		}
+		name = cluster.GetClusterName()
+	}
+
+	_, err := r.client.OperatorService().RemoveRemoteCluster(ctx, &operatorservice.RemoveRemoteClusterRequest{
+		ClusterName: name,
+	})
+	// The remote cluster is already gone, nothing to delete.
+	if isNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error while removing remote cluster "+name, serviceErrorDetail(err))
+		return
+	}
+}
+
+// ImportState imports a remote cluster by its name.
+func (r *remoteClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("cluster_name"), req, resp)
+}
@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"go.temporal.io/api/operatorservice/v1"
+)
+
+func TestAccRemoteClusterResource(t *testing.T) {
+	// A second cluster is needed to connect to, e.g. a standby started with
+	// its own cluster name.
+	remoteAddress := os.Getenv("TEMPORAL_REMOTE_ADDRESS")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			if remoteAddress == "" {
+				t.Skip("TEMPORAL_REMOTE_ADDRESS must be set to test remote clusters")
+			}
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testProviderConfig + `
+resource "temporal_remote_cluster" "example" {
+  frontend_address = "` + remoteAddress + `"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_remote_cluster.example", "frontend_address", remoteAddress),
+					resource.TestCheckResourceAttr("temporal_remote_cluster.example", "enable_remote_cluster_connection", "true"),
+					resource.TestCheckResourceAttrSet("temporal_remote_cluster.example", "cluster_name"),
+					resource.TestCheckResourceAttrSet("temporal_remote_cluster.example", "cluster_id"),
+					resource.TestCheckResourceAttrSet("temporal_remote_cluster.example", "history_shard_count"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "temporal_remote_cluster.example",
+				ImportState:  true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					return s.RootModule().Resources["temporal_remote_cluster.example"].Primary.Attributes["cluster_name"], nil
+				},
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "cluster_name",
+			},
+			// Update testing
+			{
+				Config: testProviderConfig + `
+resource "temporal_remote_cluster" "example" {
+  frontend_address                 = "` + remoteAddress + `"
+  enable_remote_cluster_connection = false
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_remote_cluster.example", "enable_remote_cluster_connection", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAddedClusters(t *testing.T) {
+	local := &operatorservice.ClusterMetadata{ClusterName: "active", Address: "localhost:7233"}
+	existing := &operatorservice.ClusterMetadata{ClusterName: "standby", Address: "standby:7233"}
+	// The server lists the new cluster with a resolved address.
+	added := &operatorservice.ClusterMetadata{ClusterName: "dr", Address: "10.0.0.3:7233"}
+
+	got := addedClusters(
+		[]*operatorservice.ClusterMetadata{local, existing},
+		[]*operatorservice.ClusterMetadata{local, existing, added},
+	)
+	if len(got) != 1 || got[0].GetClusterName() != "dr" {
+		t.Errorf("got added clusters %v, want only dr", got)
+	}
+
+	if got := addedClusters([]*operatorservice.ClusterMetadata{local, existing}, []*operatorservice.ClusterMetadata{local, existing}); len(got) != 0 {
+		t.Errorf("got added clusters %v, want none when updating a known cluster", got)
+	}
+}
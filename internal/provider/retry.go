@@ -0,0 +1,156 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultRetryMaxAttempts    = 5
+	defaultRetryInitialBackoff = time.Second
+	defaultRetryMaxBackoff     = 30 * time.Second
+)
+
+// retryableMethods lists the calls that are safe to retry, as they are either
+// read-only or idempotent. Retrying a delete that went through yields a not
+// found error, which the resources treat as success. Creations and updates are
+// not retried, as a retry of a call that went through but whose response was
+// lost would be applied twice or fail.
+var retryableMethods = map[string]bool{
+	workflowservice.WorkflowService_DescribeNamespace_FullMethodName:       true,
+	workflowservice.WorkflowService_ListNamespaces_FullMethodName:          true,
+	workflowservice.WorkflowService_DescribeSchedule_FullMethodName:        true,
+	workflowservice.WorkflowService_ListSchedules_FullMethodName:           true,
+	workflowservice.WorkflowService_CountWorkflowExecutions_FullMethodName: true,
+	workflowservice.WorkflowService_GetClusterInfo_FullMethodName:          true,
+	workflowservice.WorkflowService_GetSystemInfo_FullMethodName:           true,
+	workflowservice.WorkflowService_GetSearchAttributes_FullMethodName:     true,
+	operatorservice.OperatorService_ListSearchAttributes_FullMethodName:    true,
+	operatorservice.OperatorService_ListClusters_FullMethodName:            true,
+	workflowservice.WorkflowService_DeleteSchedule_FullMethodName:          true,
+	operatorservice.OperatorService_DeleteNamespace_FullMethodName:         true,
+	operatorservice.OperatorService_RemoveSearchAttributes_FullMethodName:  true,
+	operatorservice.OperatorService_RemoveRemoteCluster_FullMethodName:     true,
+}
+
+// sdkRetriesKey marks the context of the calls the SDK retries itself.
+type sdkRetriesKey struct{}
+
+// withSDKRetries returns a context whose calls are not retried by the
+// provider, as the SDK already retries them, e.g. those of the
+// ScheduleClient. Retrying them on top would multiply both retry budgets.
+func withSDKRetries(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sdkRetriesKey{}, true)
+}
+
+type retryModel struct {
+	MaxAttempts    types.Int64  `tfsdk:"max_attempts"`
+	InitialBackoff types.String `tfsdk:"initial_backoff"`
+	MaxBackoff     types.String `tfsdk:"max_backoff"`
+}
+
+// retryConfig holds the retry policy of the calls to the Temporal server.
+type retryConfig struct {
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+func buildRetryConfig(m *retryModel) (retryConfig, error) {
+	cfg := retryConfig{
+		maxAttempts:    defaultRetryMaxAttempts,
+		initialBackoff: defaultRetryInitialBackoff,
+		maxBackoff:     defaultRetryMaxBackoff,
+	}
+	if m == nil {
+		return cfg, nil
+	}
+
+	if !m.MaxAttempts.IsNull() {
+		if m.MaxAttempts.ValueInt64() < 1 {
+			return cfg, errors.New("max_attempts must be at least 1")
+		}
+		cfg.maxAttempts = int(m.MaxAttempts.ValueInt64())
+	}
+	if !m.InitialBackoff.IsNull() {
+		d, err := parseDuration(m.InitialBackoff.ValueString())
+		if err != nil {
+			return cfg, errors.New("invalid initial_backoff: " + err.Error())
+		}
+		if d < 0 {
+			return cfg, errors.New("initial_backoff must not be negative")
+		}
+		cfg.initialBackoff = d
+	}
+	if !m.MaxBackoff.IsNull() {
+		d, err := parseDuration(m.MaxBackoff.ValueString())
+		if err != nil {
+			return cfg, errors.New("invalid max_backoff: " + err.Error())
+		}
+		cfg.maxBackoff = d
+	}
+	if cfg.maxBackoff < cfg.initialBackoff {
+		return cfg, errors.New("max_backoff must not be lower than initial_backoff")
+	}
+	return cfg, nil
+}
+
+// isRetryableCode reports whether a call failing with the code may succeed
+// when retried.
+func isRetryableCode(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted:
+		return true
+	}
+	return false
+}
+
+// jitter returns a random delay between half the backoff and the backoff, so
+// that concurrent calls failing together are not retried together.
+func jitter(backoff time.Duration) time.Duration {
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// interceptor retries the failed retryable calls with a jittered exponential
+// backoff.
+func (c retryConfig) interceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if c.maxAttempts <= 1 || !retryableMethods[method] || ctx.Value(sdkRetriesKey{}) != nil {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		backoff := c.initialBackoff
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= c.maxAttempts || !isRetryableCode(status.Code(err)) {
+				return err
+			}
+
+			delay := jitter(backoff)
+			tflog.Warn(ctx, "Temporal API call failed, retrying", map[string]any{
+				"method":       method,
+				"attempt":      attempt,
+				"max_attempts": c.maxAttempts,
+				"backoff":      delay.String(),
+				"error":        err.Error(),
+			})
+
+			select {
+			case <-ctx.Done():
+				return err
+			case <-time.After(delay):
+			}
+			backoff = min(2*backoff, c.maxBackoff)
+		}
+	}
+}
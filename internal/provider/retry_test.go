@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"go.temporal.io/api/workflowservice/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// flakyWorkflowService fails every call with failCode until failures calls
+// have been made.
+type flakyWorkflowService struct {
+	workflowservice.UnimplementedWorkflowServiceServer
+
+	failures int32
+	failCode codes.Code
+	calls    atomic.Int32
+}
+
+func (s *flakyWorkflowService) fail() error {
+	if s.calls.Add(1) <= s.failures {
+		return status.Error(s.failCode, "injected failure")
+	}
+	return nil
+}
+
+func (s *flakyWorkflowService) DescribeNamespace(context.Context, *workflowservice.DescribeNamespaceRequest) (*workflowservice.DescribeNamespaceResponse, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &workflowservice.DescribeNamespaceResponse{}, nil
+}
+
+func (s *flakyWorkflowService) RegisterNamespace(context.Context, *workflowservice.RegisterNamespaceRequest) (*workflowservice.RegisterNamespaceResponse, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &workflowservice.RegisterNamespaceResponse{}, nil
+}
+
+func (s *flakyWorkflowService) UpdateNamespace(context.Context, *workflowservice.UpdateNamespaceRequest) (*workflowservice.UpdateNamespaceResponse, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &workflowservice.UpdateNamespaceResponse{}, nil
+}
+
+func (s *flakyWorkflowService) DeleteSchedule(context.Context, *workflowservice.DeleteScheduleRequest) (*workflowservice.DeleteScheduleResponse, error) {
+	if err := s.fail(); err != nil {
+		return nil, err
+	}
+	return &workflowservice.DeleteScheduleResponse{}, nil
+}
+
+// newTestWorkflowServiceClient serves service in memory and returns a client
+// calling it through interceptors.
+func newTestWorkflowServiceClient(t *testing.T, service workflowservice.WorkflowServiceServer, interceptors ...grpc.UnaryClientInterceptor) workflowservice.WorkflowServiceClient {
+	t.Helper()
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	workflowservice.RegisterWorkflowServiceServer(server, service)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithChainUnaryInterceptor(interceptors...),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return workflowservice.NewWorkflowServiceClient(conn)
+}
+
+func TestRetryInterceptor(t *testing.T) {
+	retry := retryConfig{maxAttempts: 4, initialBackoff: time.Millisecond, maxBackoff: 2 * time.Millisecond}
+
+	tests := []struct {
+		name      string
+		failures  int32
+		failCode  codes.Code
+		call      func(workflowservice.WorkflowServiceClient) error
+		wantCode  codes.Code
+		wantCalls int32
+	}{
+		{
+			name:     "retries a read until it succeeds",
+			failures: 3,
+			failCode: codes.Unavailable,
+			call: func(c workflowservice.WorkflowServiceClient) error {
+				_, err := c.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{Namespace: "test"})
+				return err
+			},
+			wantCode:  codes.OK,
+			wantCalls: 4,
+		},
+		{
+			name:     "stops after the maximum number of attempts",
+			failures: 10,
+			failCode: codes.ResourceExhausted,
+			call: func(c workflowservice.WorkflowServiceClient) error {
+				_, err := c.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{Namespace: "test"})
+				return err
+			},
+			wantCode:  codes.ResourceExhausted,
+			wantCalls: 4,
+		},
+		{
+			name:     "does not retry a non transient error",
+			failures: 10,
+			failCode: codes.InvalidArgument,
+			call: func(c workflowservice.WorkflowServiceClient) error {
+				_, err := c.DescribeNamespace(context.Background(), &workflowservice.DescribeNamespaceRequest{Namespace: "test"})
+				return err
+			},
+			wantCode:  codes.InvalidArgument,
+			wantCalls: 1,
+		},
+		{
+			name:     "does not retry a creation",
+			failures: 10,
+			failCode: codes.Unavailable,
+			call: func(c workflowservice.WorkflowServiceClient) error {
+				_, err := c.RegisterNamespace(context.Background(), &workflowservice.RegisterNamespaceRequest{Namespace: "test"})
+				return err
+			},
+			wantCode:  codes.Unavailable,
+			wantCalls: 1,
+		},
+		{
+			name:     "retries an idempotent delete",
+			failures: 1,
+			failCode: codes.Unavailable,
+			call: func(c workflowservice.WorkflowServiceClient) error {
+				_, err := c.DeleteSchedule(context.Background(), &workflowservice.DeleteScheduleRequest{Namespace: "test", ScheduleId: "test"})
+				return err
+			},
+			wantCode:  codes.OK,
+			wantCalls: 2,
+		},
+		{
+			name:     "does not retry a call the SDK retries",
+			failures: 10,
+			failCode: codes.Unavailable,
+			call: func(c workflowservice.WorkflowServiceClient) error {
+				_, err := c.DescribeNamespace(withSDKRetries(context.Background()), &workflowservice.DescribeNamespaceRequest{Namespace: "test"})
+				return err
+			},
+			wantCode:  codes.Unavailable,
+			wantCalls: 1,
+		},
+		{
+			name:     "does not retry an update",
+			failures: 10,
+			failCode: codes.Unavailable,
+			call: func(c workflowservice.WorkflowServiceClient) error {
+				_, err := c.UpdateNamespace(context.Background(), &workflowservice.UpdateNamespaceRequest{Namespace: "test"})
+				return err
+			},
+			wantCode:  codes.Unavailable,
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := &flakyWorkflowService{failures: tt.failures, failCode: tt.failCode}
+			c := newTestWorkflowServiceClient(t, service, retry.interceptor())
+
+			err := tt.call(c)
+			if got := status.Code(err); got != tt.wantCode {
+				t.Errorf("got code %v, want %v (%v)", got, tt.wantCode, err)
+			}
+			if got := service.calls.Load(); got != tt.wantCalls {
+				t.Errorf("got %d calls, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestJitter(t *testing.T) {
+	for _, backoff := range []time.Duration{0, time.Nanosecond, time.Second, time.Minute} {
+		for range 100 {
+			if got := jitter(backoff); got < backoff/2 || got > backoff {
+				t.Fatalf("jitter(%v) = %v, want between %v and %v", backoff, got, backoff/2, backoff)
+			}
+		}
+	}
+}
+
+func TestBuildRetryConfig(t *testing.T) {
+	cfg, err := buildRetryConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.maxAttempts != defaultRetryMaxAttempts || cfg.initialBackoff != defaultRetryInitialBackoff || cfg.maxBackoff != defaultRetryMaxBackoff {
+		t.Errorf("unexpected default configuration %+v", cfg)
+	}
+
+	cfg, err = buildRetryConfig(&retryModel{
+		MaxAttempts:    types.Int64Value(3),
+		InitialBackoff: types.StringValue("2s"),
+		MaxBackoff:     types.StringValue("1m"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.maxAttempts != 3 || cfg.initialBackoff != 2*time.Second || cfg.maxBackoff != time.Minute {
+		t.Errorf("unexpected configuration %+v", cfg)
+	}
+
+	if _, err := buildRetryConfig(&retryModel{
+		MaxAttempts:    types.Int64Null(),
+		InitialBackoff: types.StringValue("1m"),
+		MaxBackoff:     types.StringValue("10s"),
+	}); err == nil {
+		t.Error("expected an error when max_backoff is lower than initial_backoff")
+	}
+
+	if _, err := buildRetryConfig(&retryModel{
+		MaxAttempts:    types.Int64Null(),
+		InitialBackoff: types.StringValue("-1s"),
+		MaxBackoff:     types.StringNull(),
+	}); err == nil {
+		t.Error("expected an error when initial_backoff is negative")
+	}
+}
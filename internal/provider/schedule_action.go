@@ -0,0 +1,384 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	temporal "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	sdktemporal "go.temporal.io/sdk/temporal"
+)
+
+// searchAttributeTypes lists the search attribute types, as named in payload metadata.
+var searchAttributeTypes = []string{"Text", "Keyword", "Int", "Double", "Bool", "Datetime", "KeywordList"}
+
+// scheduleWorkflowAction converts the action model into the client
+// representation, encoding the input payloads with the codec if any.
+func (m scheduleActionModel) scheduleWorkflowAction(codec converter.PayloadCodec) (*temporal.ScheduleWorkflowAction, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	payloads := make([]*commonpb.Payload, 0)
+	for i, input := range m.inputs() {
+		p, err := input.payload()
+		if err != nil {
+			diags.AddError("Invalid input payload", fmt.Sprintf("Workflow argument %d: %s.", i, err.Error()))
+			continue
+		}
+		payloads = append(payloads, p)
+	}
+	if codec != nil && len(payloads) > 0 {
+		var err error
+		payloads, err = codec.Encode(payloads)
+		if err != nil {
+			diags.AddError("Unable to encode the input payloads with the payload codec", err.Error())
+		}
+	}
+
+	// Pass the payloads already encoded so that they read back as written.
+	var args = make([]interface{}, 0, len(payloads))
+	for _, p := range payloads {
+		args = append(args, p)
+	}
+
+	action := &temporal.ScheduleWorkflowAction{
+		Workflow:                 m.WorkflowType.ValueString(),
+		TaskQueue:                m.TaskQueueName.ValueString(),
+		Args:                     args,
+		WorkflowExecutionTimeout: optionalDuration(&diags, "workflow_execution_timeout", m.WorkflowExecutionTimeout),
+		WorkflowRunTimeout:       optionalDuration(&diags, "workflow_run_timeout", m.WorkflowRunTimeout),
+		WorkflowTaskTimeout:      optionalDuration(&diags, "workflow_task_timeout", m.WorkflowTaskTimeout),
+	}
+
+	// Only set the workflow ID if it's known, Temporal generates one otherwise.
+	if !m.WorkflowId.IsNull() && !m.WorkflowId.IsUnknown() {
+		action.ID = m.WorkflowId.ValueString()
+	}
+
+	if m.RetryPolicy != nil {
+		action.RetryPolicy = &sdktemporal.RetryPolicy{
+			InitialInterval:        optionalDuration(&diags, "retry_policy.initial_interval", m.RetryPolicy.InitialInterval),
+			MaximumInterval:        optionalDuration(&diags, "retry_policy.maximum_interval", m.RetryPolicy.MaximumInterval),
+			BackoffCoefficient:     m.RetryPolicy.BackoffCoefficient.ValueFloat64(),
+			MaximumAttempts:        int32(m.RetryPolicy.MaximumAttempts.ValueInt64()),
+			NonRetryableErrorTypes: stringValues(m.RetryPolicy.NonRetryableErrorTypes),
+		}
+	}
+
+	if len(m.Memo) > 0 {
+		action.Memo = make(map[string]interface{}, len(m.Memo))
+		for k, v := range m.Memo {
+			if !json.Valid([]byte(v)) {
+				diags.AddError("Invalid memo", "The value of memo "+k+" must be a valid JSON string.")
+				continue
+			}
+			action.Memo[k] = jsonPayload(v)
+		}
+	}
+
+	updates := make([]sdktemporal.SearchAttributeUpdate, 0, len(m.SearchAttributes))
+	for _, sa := range m.SearchAttributes {
+		update, err := sa.searchAttributeUpdate()
+		if err != nil {
+			diags.AddError("Invalid search attribute "+sa.Name.ValueString(), err.Error())
+			continue
+		}
+		updates = append(updates, update)
+	}
+	action.TypedSearchAttributes = sdktemporal.NewSearchAttributes(updates...)
+
+	return action, diags
+}
+
+func (m scheduleSearchAttributeModel) searchAttributeUpdate() (sdktemporal.SearchAttributeUpdate, error) {
+	name, value := m.Name.ValueString(), m.Value.ValueString()
+	switch m.Type.ValueString() {
+	case "Text":
+		return sdktemporal.NewSearchAttributeKeyString(name).ValueSet(value), nil
+	case "Keyword":
+		return sdktemporal.NewSearchAttributeKeyKeyword(name).ValueSet(value), nil
+	case "Int":
+		v, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid integer", value)
+		}
+		return sdktemporal.NewSearchAttributeKeyInt64(name).ValueSet(v), nil
+	case "Double":
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid number", value)
+		}
+		return sdktemporal.NewSearchAttributeKeyFloat64(name).ValueSet(v), nil
+	case "Bool":
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid boolean", value)
+		}
+		return sdktemporal.NewSearchAttributeKeyBool(name).ValueSet(v), nil
+	case "Datetime":
+		v, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid RFC3339 timestamp", value)
+		}
+		return sdktemporal.NewSearchAttributeKeyTime(name).ValueSet(v), nil
+	case "KeywordList":
+		var v []string
+		if err := json.Unmarshal([]byte(value), &v); err != nil {
+			return nil, fmt.Errorf("%q is not a valid JSON array of strings", value)
+		}
+		return sdktemporal.NewSearchAttributeKeyKeywordList(name).ValueSet(v), nil
+	default:
+		return nil, fmt.Errorf("unknown search attribute type %q", m.Type.ValueString())
+	}
+}
+
+// parseScheduleAction reads the workflow action back from the server,
+// decoding the input payloads with the codec if any.
+func parseScheduleAction(info *workflowpb.NewWorkflowExecutionInfo, codec converter.PayloadCodec) (scheduleActionModel, error) {
+	payloads := info.GetInput().GetPayloads()
+	if codec != nil && len(payloads) > 0 {
+		var err error
+		payloads, err = codec.Decode(payloads)
+		if err != nil {
+			return scheduleActionModel{}, fmt.Errorf("decoding the input payloads with the payload codec: %w", err)
+		}
+	}
+
+	// Plain JSON arguments are read back into the input_payload(s) shorthands,
+	// see matchInputPayloads to keep the attribute used in the configuration.
+	inputPayload := types.StringNull()
+	var inputPayloads []basetypes.StringValue
+	var inputs []schedulePayloadModel
+	allJSON := true
+	for _, p := range payloads {
+		allJSON = allJSON && isJSONPayload(p)
+	}
+	switch {
+	case !allJSON:
+		for _, p := range payloads {
+			inputs = append(inputs, parsePayload(p))
+		}
+	case len(payloads) == 1:
+		inputPayload = types.StringValue(string(payloads[0].GetData()))
+	default:
+		for _, p := range payloads {
+			inputPayloads = append(inputPayloads, types.StringValue(string(p.GetData())))
+		}
+	}
+
+	var retryPolicy *scheduleRetryPolicyModel
+	if rp := info.GetRetryPolicy(); rp != nil {
+		var nonRetryableErrorTypes []basetypes.StringValue
+		for _, t := range rp.GetNonRetryableErrorTypes() {
+			nonRetryableErrorTypes = append(nonRetryableErrorTypes, types.StringValue(t))
+		}
+		retryPolicy = &scheduleRetryPolicyModel{
+			InitialInterval:        types.StringValue(formatDuration(rp.GetInitialInterval().AsDuration())),
+			MaximumInterval:        types.StringValue(formatDuration(rp.GetMaximumInterval().AsDuration())),
+			BackoffCoefficient:     types.Float64Value(rp.GetBackoffCoefficient()),
+			MaximumAttempts:        types.Int64Value(int64(rp.GetMaximumAttempts())),
+			NonRetryableErrorTypes: nonRetryableErrorTypes,
+		}
+	}
+
+	var memo map[string]string
+	if fields := info.GetMemo().GetFields(); len(fields) > 0 {
+		memo = make(map[string]string, len(fields))
+		for k, v := range fields {
+			memo[k] = string(v.GetData())
+		}
+	}
+
+	var searchAttributes []scheduleSearchAttributeModel
+	for name, payload := range info.GetSearchAttributes().GetIndexedFields() {
+		searchAttributes = append(searchAttributes, parseSearchAttribute(name, payload))
+	}
+	sort.Slice(searchAttributes, func(i, j int) bool {
+		return searchAttributes[i].Name.ValueString() < searchAttributes[j].Name.ValueString()
+	})
+
+	return scheduleActionModel{
+		InputPayload:             inputPayload,
+		InputPayloads:            inputPayloads,
+		Inputs:                   inputs,
+		WorkflowId:               types.StringValue(info.GetWorkflowId()),
+		WorkflowType:             types.StringValue(info.GetWorkflowType().GetName()),
+		TaskQueueName:            types.StringValue(info.GetTaskQueue().GetName()),
+		WorkflowExecutionTimeout: types.StringValue(formatDuration(info.GetWorkflowExecutionTimeout().AsDuration())),
+		WorkflowRunTimeout:       types.StringValue(formatDuration(info.GetWorkflowRunTimeout().AsDuration())),
+		WorkflowTaskTimeout:      types.StringValue(formatDuration(info.GetWorkflowTaskTimeout().AsDuration())),
+		RetryPolicy:              retryPolicy,
+		Memo:                     memo,
+		SearchAttributes:         searchAttributes,
+	}, nil
+}
+
+// inputs returns the workflow arguments, whichever attribute sets them.
+func (m scheduleActionModel) inputs() []schedulePayloadModel {
+	if m.Inputs != nil {
+		return m.Inputs
+	}
+	values := m.InputPayloads
+	if !m.InputPayload.IsNull() {
+		values = []basetypes.StringValue{m.InputPayload}
+	}
+	inputs := make([]schedulePayloadModel, 0, len(values))
+	for _, v := range values {
+		inputs = append(inputs, schedulePayloadModel{
+			Data:        v,
+			Encoding:    types.StringValue(converter.MetadataEncodingJSON),
+			MessageType: types.StringNull(),
+		})
+	}
+	return inputs
+}
+
+// matchInputPayloads moves plain JSON workflow arguments to the attribute used
+// by the given configured action, as they can be set through any of them.
+func (m *scheduleActionModel) matchInputPayloads(configured scheduleActionModel) {
+	if m.Inputs != nil {
+		// Arguments that are not plain JSON can only be set through input blocks.
+		return
+	}
+
+	switch {
+	case configured.Inputs != nil:
+		m.Inputs = m.inputs()
+		m.InputPayload = types.StringNull()
+		m.InputPayloads = nil
+	case configured.InputPayloads != nil:
+		if !m.InputPayload.IsNull() {
+			m.InputPayloads = []basetypes.StringValue{m.InputPayload}
+			m.InputPayload = types.StringNull()
+		}
+		if m.InputPayloads == nil {
+			m.InputPayloads = []basetypes.StringValue{}
+		}
+	}
+}
+
+// matchConfigured keeps the configured representation of the action read from
+// the server wherever both are equivalent, e.g. a "60m" timeout read back as
+// "1h" or a "True" boolean search attribute read back as "true".
+func (m *scheduleActionModel) matchConfigured(configured scheduleActionModel) {
+	m.matchInputPayloads(configured)
+
+	for _, d := range []struct{ parsed, configured *basetypes.StringValue }{
+		{&m.WorkflowExecutionTimeout, &configured.WorkflowExecutionTimeout},
+		{&m.WorkflowRunTimeout, &configured.WorkflowRunTimeout},
+		{&m.WorkflowTaskTimeout, &configured.WorkflowTaskTimeout},
+	} {
+		if equivalentDurations(*d.parsed, *d.configured) {
+			*d.parsed = *d.configured
+		}
+	}
+
+	if m.RetryPolicy != nil && configured.RetryPolicy != nil {
+		if equivalentDurations(m.RetryPolicy.InitialInterval, configured.RetryPolicy.InitialInterval) {
+			m.RetryPolicy.InitialInterval = configured.RetryPolicy.InitialInterval
+		}
+		if equivalentDurations(m.RetryPolicy.MaximumInterval, configured.RetryPolicy.MaximumInterval) {
+			m.RetryPolicy.MaximumInterval = configured.RetryPolicy.MaximumInterval
+		}
+	}
+
+	for k, v := range m.Memo {
+		if c, ok := configured.Memo[k]; ok && equivalentJSON(v, c) {
+			m.Memo[k] = c
+		}
+	}
+
+	for i, sa := range m.SearchAttributes {
+		for _, c := range configured.SearchAttributes {
+			if c.Name.Equal(sa.Name) && c.Type.Equal(sa.Type) &&
+				equivalentSearchAttributeValues(sa.Type.ValueString(), sa.Value.ValueString(), c.Value.ValueString()) {
+				m.SearchAttributes[i].Value = c.Value
+			}
+		}
+	}
+}
+
+// equivalentSearchAttributeValues reports whether both values are the same
+// value of the search attribute type, e.g. "1.50" and "1.5" for a Double.
+func equivalentSearchAttributeValues(saType, a, b string) bool {
+	switch saType {
+	case "Int":
+		va, errA := strconv.ParseInt(a, 10, 64)
+		vb, errB := strconv.ParseInt(b, 10, 64)
+		return errA == nil && errB == nil && va == vb
+	case "Double":
+		va, errA := strconv.ParseFloat(a, 64)
+		vb, errB := strconv.ParseFloat(b, 64)
+		return errA == nil && errB == nil && va == vb
+	case "Bool":
+		va, errA := strconv.ParseBool(a)
+		vb, errB := strconv.ParseBool(b)
+		return errA == nil && errB == nil && va == vb
+	case "Datetime":
+		va, errA := time.Parse(time.RFC3339, a)
+		vb, errB := time.Parse(time.RFC3339, b)
+		return errA == nil && errB == nil && va.Equal(vb)
+	case "KeywordList":
+		return equivalentJSON(a, b)
+	}
+	return a == b
+}
+
+// equivalentJSON reports whether both strings hold the same JSON value,
+// regardless of formatting.
+func equivalentJSON(a, b string) bool {
+	var va, vb interface{}
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+func parseSearchAttribute(name string, payload *commonpb.Payload) scheduleSearchAttributeModel {
+	saType := string(payload.GetMetadata()["type"])
+	value := string(payload.GetData())
+	// String-like values are stored as JSON strings, expose them unquoted.
+	switch saType {
+	case "Text", "Keyword", "Datetime":
+		var s string
+		if err := json.Unmarshal(payload.GetData(), &s); err == nil {
+			value = s
+		}
+	}
+	return scheduleSearchAttributeModel{
+		Name:  types.StringValue(name),
+		Type:  types.StringValue(saType),
+		Value: types.StringValue(value),
+	}
+}
+
+// optionalDuration parses a duration attribute, treating null and unknown values as zero.
+func optionalDuration(diags *diag.Diagnostics, attribute string, value basetypes.StringValue) time.Duration {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return 0
+	}
+	d, err := parseDuration(value.ValueString())
+	if err != nil {
+		diags.AddError("Error parsing "+attribute+" as a duration", value.ValueString())
+	}
+	return d
+}
+
+func stringValues(values []basetypes.StringValue) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	res := make([]string, 0, len(values))
+	for _, v := range values {
+		res = append(res, v.ValueString())
+	}
+	return res
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func TestScheduleActionMatchConfigured(t *testing.T) {
+	searchAttribute := func(saType, value string) scheduleSearchAttributeModel {
+		return scheduleSearchAttributeModel{
+			Name:  types.StringValue("Attribute" + saType),
+			Type:  types.StringValue(saType),
+			Value: types.StringValue(value),
+		}
+	}
+
+	parsed := scheduleActionModel{
+		InputPayload:             types.StringNull(),
+		WorkflowExecutionTimeout: types.StringValue("1h"),
+		WorkflowRunTimeout:       types.StringValue("30m"),
+		WorkflowTaskTimeout:      types.StringValue("10s"),
+		RetryPolicy: &scheduleRetryPolicyModel{
+			InitialInterval: types.StringValue("1m"),
+			MaximumInterval: types.StringValue("1h"),
+		},
+		Memo: map[string]string{"owner": `{"team":"payments"}`},
+		SearchAttributes: []scheduleSearchAttributeModel{
+			searchAttribute("Bool", "true"),
+			searchAttribute("Datetime", "2025-01-01T00:00:00Z"),
+			searchAttribute("Double", "1.5"),
+			searchAttribute("Int", "7"),
+			searchAttribute("KeywordList", `["a","b"]`),
+		},
+	}
+	configured := scheduleActionModel{
+		InputPayload:             types.StringNull(),
+		WorkflowExecutionTimeout: types.StringValue("60m"),
+		WorkflowRunTimeout:       types.StringValue("1h"),
+		WorkflowTaskTimeout:      types.StringNull(),
+		RetryPolicy: &scheduleRetryPolicyModel{
+			InitialInterval: types.StringValue("60s"),
+			MaximumInterval: types.StringUnknown(),
+		},
+		Memo: map[string]string{"owner": `{ "team": "payments" }`},
+		SearchAttributes: []scheduleSearchAttributeModel{
+			searchAttribute("Bool", "True"),
+			searchAttribute("Datetime", "2025-01-01T01:00:00+01:00"),
+			searchAttribute("Double", "1.50"),
+			searchAttribute("Int", "8"),
+			searchAttribute("KeywordList", `[ "a", "b" ]`),
+		},
+	}
+
+	parsed.matchConfigured(configured)
+
+	for _, tt := range []struct {
+		name string
+		got  basetypes.StringValue
+		want string
+	}{
+		{"equivalent timeout", parsed.WorkflowExecutionTimeout, "60m"},
+		{"different timeout", parsed.WorkflowRunTimeout, "30m"},
+		{"timeout not configured", parsed.WorkflowTaskTimeout, "10s"},
+		{"equivalent retry interval", parsed.RetryPolicy.InitialInterval, "60s"},
+		{"unknown retry interval", parsed.RetryPolicy.MaximumInterval, "1h"},
+		{"Bool search attribute", parsed.SearchAttributes[0].Value, "True"},
+		{"Datetime search attribute", parsed.SearchAttributes[1].Value, "2025-01-01T01:00:00+01:00"},
+		{"Double search attribute", parsed.SearchAttributes[2].Value, "1.50"},
+		{"different Int search attribute", parsed.SearchAttributes[3].Value, "7"},
+		{"KeywordList search attribute", parsed.SearchAttributes[4].Value, `[ "a", "b" ]`},
+	} {
+		if tt.got.ValueString() != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.name, tt.got.ValueString(), tt.want)
+		}
+	}
+	if got := parsed.Memo["owner"]; got != configured.Memo["owner"] {
+		t.Errorf("memo: got %q, want the configured %q", got, configured.Memo["owner"])
+	}
+}
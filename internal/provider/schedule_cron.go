@@ -0,0 +1,194 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.temporal.io/api/schedule/v1"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Bounds of the year field of cron expressions, as accepted by the server.
+const (
+	cronMinYear = 2000
+	cronMaxYear = 2100
+)
+
+var (
+	cronMonthNames   = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+	cronWeekdayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+)
+
+// parseCronExpression translates a cron expression the way the server does
+// when storing a schedule, into either a calendar or, for "@every", an
+// interval. A CRON_TZ or TZ prefix is ignored, as it sets the time zone of the
+// whole spec instead.
+func parseCronExpression(expr string) (*schedule.StructuredCalendarSpec, *schedule.IntervalSpec, error) {
+	expr = strings.TrimSpace(expr)
+	if strings.HasPrefix(expr, "CRON_TZ=") || strings.HasPrefix(expr, "TZ=") {
+		_, rest, found := strings.Cut(expr, " ")
+		if !found {
+			return nil, nil, errors.New("cron expression has a time zone but no fields")
+		}
+		expr = rest
+	}
+
+	expr, comment, _ := strings.Cut(expr, "#")
+	expr = strings.TrimSpace(expr)
+	comment = strings.TrimSpace(comment)
+
+	if strings.HasPrefix(expr, "@every") {
+		interval, err := parseCronInterval(expr)
+		return nil, interval, err
+	}
+
+	switch expr {
+	case "@yearly", "@annually":
+		expr = "0 0 1 1 *"
+	case "@monthly":
+		expr = "0 0 1 * *"
+	case "@weekly":
+		expr = "0 0 * * 0"
+	case "@daily", "@midnight":
+		expr = "0 0 * * *"
+	case "@hourly":
+		expr = "0 * * * *"
+	}
+
+	// Five fields start with the minute, six add the year and seven the second.
+	var second, minute, hour, dayOfMonth, month, dayOfWeek, year string
+	fields := strings.Fields(expr)
+	switch len(fields) {
+	case 5:
+		minute, hour, dayOfMonth, month, dayOfWeek = fields[0], fields[1], fields[2], fields[3], fields[4]
+	case 6:
+		minute, hour, dayOfMonth, month, dayOfWeek, year = fields[0], fields[1], fields[2], fields[3], fields[4], fields[5]
+	case 7:
+		second, minute, hour, dayOfMonth, month, dayOfWeek, year = fields[0], fields[1], fields[2], fields[3], fields[4], fields[5], fields[6]
+	default:
+		return nil, nil, errors.New("cron expression does not have 5 to 7 fields")
+	}
+
+	var errs []error
+	parse := func(s, def string, minValue, maxValue int, names []string, firstName int) []*schedule.Range {
+		ranges, err := parseCronField(s, def, minValue, maxValue, names, firstName)
+		errs = append(errs, err)
+		return ranges
+	}
+	calendar := &schedule.StructuredCalendarSpec{
+		Second:     parse(second, "0", 0, 59, nil, 0),
+		Minute:     parse(minute, "0", 0, 59, nil, 0),
+		Hour:       parse(hour, "0", 0, 23, nil, 0),
+		DayOfMonth: parse(dayOfMonth, "*", 1, 31, nil, 0),
+		Month:      parse(month, "*", 1, 12, cronMonthNames, 1),
+		Year:       parse(year, "*", cronMinYear, cronMaxYear, nil, 0),
+		DayOfWeek:  parse(dayOfWeek, "*", 0, 7, cronWeekdayNames, 0),
+		Comment:    comment,
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, nil, err
+	}
+	return calendar, nil, nil
+}
+
+// parseCronInterval translates an "@every <interval>[/<offset>]" expression.
+func parseCronInterval(expr string) (*schedule.IntervalSpec, error) {
+	_, value, found := strings.Cut(expr, " ")
+	if !found {
+		return nil, errors.New("@every is missing its interval")
+	}
+	every, offset, _ := strings.Cut(strings.TrimSpace(value), "/")
+
+	interval := &schedule.IntervalSpec{}
+	d, err := parseCronDuration(every)
+	if err != nil {
+		return nil, err
+	}
+	interval.Interval = durationpb.New(d)
+	if offset != "" {
+		d, err := parseCronDuration(offset)
+		if err != nil {
+			return nil, err
+		}
+		interval.Phase = durationpb.New(d)
+	}
+	return interval, nil
+}
+
+// parseCronDuration parses a duration of an "@every" expression, which also
+// accepts days, e.g. "2d".
+func parseCronDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil {
+			return 0, fmt.Errorf("invalid duration %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	return time.ParseDuration(s)
+}
+
+// parseCronField translates a field of a cron expression into ranges, e.g.
+// "1-5", "*/15" or "MON,WED". A wildcard matches all values, which the server
+// stores as no range at all.
+func parseCronField(s, def string, minValue, maxValue int, names []string, firstName int) ([]*schedule.Range, error) {
+	if s == "" {
+		s = def
+	}
+	if s == "*" || s == "?" {
+		return nil, nil
+	}
+
+	parseValue := func(v string) (int, error) {
+		if len(v) >= 3 {
+			for i, name := range names {
+				if strings.EqualFold(v[:3], name) {
+					return i + firstName, nil
+				}
+			}
+		}
+		return strconv.Atoi(v)
+	}
+
+	var ranges []*schedule.Range
+	for _, part := range strings.Split(s, ",") {
+		step, hasStep := 1, false
+		if value, stepStr, found := strings.Cut(part, "/"); found {
+			n, err := strconv.Atoi(stepStr)
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid step in %q", part)
+			}
+			part, step, hasStep = value, n, true
+		}
+
+		start, end := minValue, maxValue
+		if part != "*" {
+			var err error
+			if from, to, found := strings.Cut(part, "-"); found {
+				if start, err = parseValue(from); err != nil {
+					return nil, fmt.Errorf("invalid value in %q", part)
+				}
+				if end, err = parseValue(to); err != nil {
+					return nil, fmt.Errorf("invalid value in %q", part)
+				}
+			} else {
+				if start, err = parseValue(part); err != nil {
+					return nil, fmt.Errorf("invalid value in %q", part)
+				}
+				// Like in cron, a single value with a step runs up to the end
+				// of the field.
+				if !hasStep {
+					end = start
+				}
+			}
+		}
+		if start < minValue || start > maxValue || end < start || end > maxValue {
+			return nil, fmt.Errorf("%q is out of range %d-%d", part, minValue, maxValue)
+		}
+		ranges = append(ranges, &schedule.Range{Start: int32(start), End: int32(end), Step: int32(step)})
+	}
+	return ranges, nil
+}
@@ -0,0 +1,421 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporal "go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &scheduleDataSource{}
+	_ datasource.DataSourceWithConfigure = &scheduleDataSource{}
+)
+
+func NewScheduleDataSource() datasource.DataSource {
+	return &scheduleDataSource{}
+}
+
+type scheduleDataSource struct {
+	client    temporal.Client
+	namespace string
+	provider  *providerConfig
+}
+
+type scheduleActionResultModel struct {
+	ScheduleTime basetypes.StringValue `tfsdk:"schedule_time"`
+	ActualTime   basetypes.StringValue `tfsdk:"actual_time"`
+	WorkflowId   basetypes.StringValue `tfsdk:"workflow_id"`
+	RunId        basetypes.StringValue `tfsdk:"run_id"`
+}
+
+type scheduleDataSourceModel struct {
+	Name            basetypes.StringValue       `tfsdk:"name"`
+	Namespace       basetypes.StringValue       `tfsdk:"namespace"`
+	IsPaused        basetypes.BoolValue         `tfsdk:"is_paused"`
+	Action          scheduleActionModel         `tfsdk:"action"`
+	OverlapPolicy   basetypes.StringValue       `tfsdk:"overlap_policy"`
+	CatchupWindow   basetypes.StringValue       `tfsdk:"catchup_window"`
+	PauseOnFailure  basetypes.BoolValue         `tfsdk:"pause_on_failure"`
+	Spec            scheduleSpecModel           `tfsdk:"spec"`
+	NextActionTimes []basetypes.StringValue     `tfsdk:"next_action_times"`
+	RecentActions   []scheduleActionResultModel `tfsdk:"recent_actions"`
+}
+
+// parseScheduleActionTimes formats the action times of a schedule.
+func parseScheduleActionTimes(times []*timestamppb.Timestamp) []basetypes.StringValue {
+	result := make([]basetypes.StringValue, 0, len(times))
+	for _, t := range times {
+		result = append(result, parseScheduleTimestamp(t))
+	}
+	return result
+}
+
+// parseScheduleActionResults reads the most recent actions of a schedule.
+func parseScheduleActionResults(results []*schedulepb.ScheduleActionResult) []scheduleActionResultModel {
+	actions := make([]scheduleActionResultModel, 0, len(results))
+	for _, r := range results {
+		actions = append(actions, scheduleActionResultModel{
+			ScheduleTime: parseScheduleTimestamp(r.GetScheduleTime()),
+			ActualTime:   parseScheduleTimestamp(r.GetActualTime()),
+			WorkflowId:   optionalStringValue(r.GetStartWorkflowResult().GetWorkflowId()),
+			RunId:        optionalStringValue(r.GetStartWorkflowResult().GetRunId()),
+		})
+	}
+	return actions
+}
+
+// scheduleActionResultsAttribute describes the most recent actions of a schedule.
+func scheduleActionResultsAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "Most recent actions taken by the schedule, oldest first.",
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"schedule_time": schema.StringAttribute{
+					Description: "Time the action was scheduled at, including jitter, as an RFC3339 timestamp.",
+					Computed:    true,
+				},
+				"actual_time": schema.StringAttribute{
+					Description: "Time the action was actually taken at, as an RFC3339 timestamp.",
+					Computed:    true,
+				},
+				"workflow_id": schema.StringAttribute{
+					Description: "ID of the started workflow execution.",
+					Computed:    true,
+				},
+				"run_id": schema.StringAttribute{
+					Description: "Run ID of the started workflow execution.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// scheduleNextActionTimesAttribute describes the upcoming actions of a schedule.
+func scheduleNextActionTimesAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		ElementType: types.StringType,
+		Description: "Times of the next actions of the schedule, as RFC3339 timestamps.",
+		Computed:    true,
+	}
+}
+
+func scheduleCalendarAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"comment": schema.StringAttribute{
+					Description: "Description of the intention of this calendar.",
+					Computed:    true,
+				},
+				"second":       scheduleRangeAttribute("Seconds to match (0-59)."),
+				"minute":       scheduleRangeAttribute("Minutes to match (0-59)."),
+				"hour":         scheduleRangeAttribute("Hours to match (0-23)."),
+				"day_of_month": scheduleRangeAttribute("Days of the month to match (1-31)."),
+				"month":        scheduleRangeAttribute("Months to match (1-12)."),
+				"year":         scheduleRangeAttribute("Years to match."),
+				"day_of_week":  scheduleRangeAttribute("Days of the week to match (0-6, 0 is Sunday)."),
+			},
+		},
+	}
+}
+
+func scheduleRangeAttribute(description string) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: description,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"start": schema.Int64Attribute{
+					Description: "Start of the range (inclusive).",
+					Computed:    true,
+				},
+				"end": schema.Int64Attribute{
+					Description: "End of the range (inclusive). Unset when equal to start.",
+					Computed:    true,
+				},
+				"step": schema.Int64Attribute{
+					Description: "Step between each value of the range.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func (d *scheduleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = cfg.client
+	d.namespace = cfg.namespace
+	d.provider = cfg
+}
+
+// Metadata returns the data source type name.
+func (d *scheduleDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedule"
+}
+
+// Schema defines the schema for the data source.
+func (d *scheduleDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `temporal_schedule` data source allows you to read an existing schedule, along with its next and most recent actions.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				Description: "Schedule name.",
+				Required:    true,
+			},
+			"namespace": schema.StringAttribute{
+				Description: "Namespace the schedule belongs to. Defaults to the provider namespace.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"is_paused": schema.BoolAttribute{
+				Description: "Whether that schedule is currently paused.",
+				Computed:    true,
+			},
+			"pause_on_failure": schema.BoolAttribute{
+				Description: "Whether that schedule is paused after a failure.",
+				Computed:    true,
+			},
+			"overlap_policy": schema.StringAttribute{
+				MarkdownDescription: "What happens when an action would be started while an older one is still running. One of: `skip`, `buffer_one`, `buffer_all`, `cancel_other`, `terminate_other`, `allow_all`.",
+				Computed:            true,
+			},
+			"catchup_window": schema.StringAttribute{
+				Description: "How long after a missed action time the action is still taken. E.g. \"10m\", \"3h\".",
+				Computed:    true,
+			},
+			"action": schema.SingleNestedAttribute{
+				Description: "Details about the action this schedule triggers.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"workflow_id": schema.StringAttribute{
+						Description: "ID given to the workflow executions this schedule starts.",
+						Computed:    true,
+					},
+					"workflow_type": schema.StringAttribute{
+						Description: "Name of the workflow definition this schedule starts.",
+						Computed:    true,
+					},
+					"task_queue_name": schema.StringAttribute{
+						Description: "Name of the queue in which the workflow executions are placed.",
+						Computed:    true,
+					},
+					"input_payload": schema.StringAttribute{
+						Description: "Single JSON argument passed to the workflow executions.",
+						Computed:    true,
+					},
+					"input_payloads": schema.ListAttribute{
+						ElementType: types.StringType,
+						Description: "JSON arguments passed to the workflow executions, when there are several of them.",
+						Computed:    true,
+					},
+					"input": schema.ListNestedAttribute{
+						MarkdownDescription: "Arguments passed to the workflow executions, when some of them are not plain JSON.",
+						Computed:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"data": schema.StringAttribute{
+									MarkdownDescription: "Payload data. A JSON string for `json/plain` and `json/protobuf`, base64 encoded bytes for `binary/plain`, and unset for `binary/null`.",
+									Computed:            true,
+								},
+								"encoding": schema.StringAttribute{
+									Description: "Payload encoding.",
+									Computed:    true,
+								},
+								"message_type": schema.StringAttribute{
+									Description: "Fully qualified protobuf message type of the payload.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+					"workflow_execution_timeout": schema.StringAttribute{
+						Description: "Timeout for the whole workflow execution, including retries and continue-as-new.",
+						Computed:    true,
+					},
+					"workflow_run_timeout": schema.StringAttribute{
+						Description: "Timeout for a single workflow run.",
+						Computed:    true,
+					},
+					"workflow_task_timeout": schema.StringAttribute{
+						Description: "Timeout for processing a workflow task.",
+						Computed:    true,
+					},
+					"retry_policy": schema.SingleNestedAttribute{
+						Description: "Retry policy of the started workflows.",
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"initial_interval": schema.StringAttribute{
+								Description: "Backoff interval for the first retry.",
+								Computed:    true,
+							},
+							"maximum_interval": schema.StringAttribute{
+								Description: "Maximum backoff interval between retries.",
+								Computed:    true,
+							},
+							"backoff_coefficient": schema.Float64Attribute{
+								Description: "Coefficient used to calculate the next retry backoff interval.",
+								Computed:    true,
+							},
+							"maximum_attempts": schema.Int64Attribute{
+								Description: "Maximum number of attempts. 0 means unlimited.",
+								Computed:    true,
+							},
+							"non_retryable_error_types": schema.ListAttribute{
+								ElementType: types.StringType,
+								Description: "Application error types that are not retried.",
+								Computed:    true,
+							},
+						},
+					},
+					"memo": schema.MapAttribute{
+						ElementType: types.StringType,
+						Description: "Non-indexed information attached to the started workflows, as JSON strings.",
+						Computed:    true,
+					},
+					"search_attributes": schema.SetNestedAttribute{
+						Description: "Typed search attributes set on the started workflows.",
+						Computed:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: "Search attribute name.",
+									Computed:    true,
+								},
+								"type": schema.StringAttribute{
+									Description: "Search attribute type.",
+									Computed:    true,
+								},
+								"value": schema.StringAttribute{
+									Description: "Search attribute value.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"spec": schema.SingleNestedAttribute{
+				Description: "Describes when the schedule actions occur.",
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"interval": schema.ListNestedAttribute{
+						Description: "Interval-based specifications of times.",
+						Computed:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"every": schema.StringAttribute{
+									Description: "Period to repeat the interval.",
+									Computed:    true,
+								},
+								"offset": schema.StringAttribute{
+									Description: "Fixed offset added to the intervals period.",
+									Computed:    true,
+								},
+							},
+						},
+					},
+					"cron_expressions": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Always empty, as the server translates cron expressions into `calendar` specifications.",
+						Computed:            true,
+					},
+					"calendar": scheduleCalendarAttribute("Calendar-based specifications of times. The fields set to their default are empty."),
+					"skip":     scheduleCalendarAttribute("Calendar-based specifications of times to skip. The fields set to their default are empty."),
+					"time_zone": schema.StringAttribute{
+						Description: "IANA time zone name the calendars are evaluated in.",
+						Computed:    true,
+					},
+					"start_at": schema.StringAttribute{
+						Description: "RFC3339 timestamp before which no action is taken.",
+						Computed:    true,
+					},
+					"end_at": schema.StringAttribute{
+						Description: "RFC3339 timestamp after which no action is taken.",
+						Computed:    true,
+					},
+					"jitter": schema.StringAttribute{
+						Description: "Random delay added to each action time, up to this amount.",
+						Computed:    true,
+					},
+				},
+			},
+			"next_action_times": scheduleNextActionTimesAttribute(),
+			"recent_actions":    scheduleActionResultsAttribute(),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *scheduleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config scheduleDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := config.Name.ValueString()
+	namespace := d.namespace
+	if config.Namespace.ValueString() != "" {
+		namespace = config.Namespace.ValueString()
+	}
+
+	schedule, err := d.client.WorkflowService().DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
+		Namespace:  namespace,
+		ScheduleId: name,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the Schedule "+name, serviceErrorDetail(err))
+		return
+	}
+
+	parsed, err := parseScheduleResource(namespace, name, schedule, d.provider.codec.payloadCodec(namespace))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading the Schedule "+name, err.Error())
+		return
+	}
+
+	data := scheduleDataSourceModel{
+		Name:            parsed.Name,
+		Namespace:       parsed.Namespace,
+		IsPaused:        parsed.IsPaused,
+		Action:          parsed.Action,
+		OverlapPolicy:   parsed.OverlapPolicy,
+		CatchupWindow:   parsed.CatchupWindow,
+		PauseOnFailure:  parsed.PauseOnFailure,
+		Spec:            parsed.Spec,
+		NextActionTimes: parseScheduleActionTimes(schedule.GetInfo().GetFutureActionTimes()),
+		RecentActions:   parseScheduleActionResults(schedule.GetInfo().GetRecentActions()),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccScheduleDataSources(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig + `
+resource "temporal_schedule" "example" {
+  name = "Example Data Source Schedule"
+
+  action {
+    workflow_type   = "exampleWorkflow"
+    task_queue_name = "example-task-queue"
+    input_payload   = jsonencode({ myVar = "abc" })
+  }
+
+  spec {
+    interval {
+      every = "1h"
+    }
+  }
+}
+
+data "temporal_schedule" "example" {
+  name = temporal_schedule.example.name
+}
+
+data "temporal_schedules" "example" {
+  query      = "TemporalSchedulePaused = false"
+  depends_on = [temporal_schedule.example]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporal_schedule.example", "namespace", "default"),
+					resource.TestCheckResourceAttr("data.temporal_schedule.example", "is_paused", "false"),
+					resource.TestCheckResourceAttr("data.temporal_schedule.example", "action.workflow_type", "exampleWorkflow"),
+					resource.TestCheckResourceAttr("data.temporal_schedule.example", "action.input_payload", "{\"myVar\":\"abc\"}"),
+					resource.TestCheckResourceAttr("data.temporal_schedule.example", "spec.interval.0.every", "1h"),
+					resource.TestCheckResourceAttrSet("data.temporal_schedule.example", "next_action_times.0"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.temporal_schedules.example", "schedules.*", map[string]string{
+						"name":          "Example Data Source Schedule",
+						"workflow_type": "exampleWorkflow",
+						"is_paused":     "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSchedulesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testProviderConfig + `
+resource "temporal_schedule" "running" {
+  name = "Example Running Schedule"
+
+  action {
+    workflow_type   = "exampleWorkflow"
+    task_queue_name = "example-task-queue"
+  }
+
+  spec {
+    interval {
+      every = "1h"
+    }
+  }
+}
+
+resource "temporal_schedule" "paused" {
+  name      = "Example Paused Schedule"
+  is_paused = true
+
+  action {
+    workflow_type   = "pausedWorkflow"
+    task_queue_name = "example-task-queue"
+  }
+
+  spec {
+    interval {
+      every = "1h"
+    }
+  }
+}
+
+data "temporal_schedules" "running" {
+  query      = "TemporalSchedulePaused = false"
+  depends_on = [temporal_schedule.running, temporal_schedule.paused]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.temporal_schedules.running", "namespace", "default"),
+					resource.TestCheckResourceAttr("data.temporal_schedules.running", "schedules.#", "1"),
+					resource.TestCheckResourceAttr("data.temporal_schedules.running", "schedules.0.name", "Example Running Schedule"),
+					resource.TestCheckResourceAttr("data.temporal_schedules.running", "schedules.0.workflow_type", "exampleWorkflow"),
+					resource.TestCheckResourceAttr("data.temporal_schedules.running", "schedules.0.is_paused", "false"),
+					resource.TestCheckResourceAttrSet("data.temporal_schedules.running", "schedules.0.next_action_times.0"),
+					resource.TestCheckResourceAttr("data.temporal_schedules.running", "schedules.0.recent_actions.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -2,18 +2,21 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"sort"
 	"terraform-provider-temporal/internal/validators"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -23,12 +26,23 @@ import (
 	"go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	temporal "go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+)
+
+// Default timeouts of the schedule operations, when not set in the timeouts
+// block of the resource.
+const (
+	scheduleCreateTimeout = time.Minute
+	scheduleReadTimeout   = time.Minute
+	scheduleUpdateTimeout = time.Minute
+	scheduleDeleteTimeout = time.Minute
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &scheduleResource{}
-	_ resource.ResourceWithConfigure = &scheduleResource{}
+	_ resource.Resource                = &scheduleResource{}
+	_ resource.ResourceWithConfigure   = &scheduleResource{}
+	_ resource.ResourceWithImportState = &scheduleResource{}
 )
 
 func NewScheduleResource() resource.Resource {
@@ -38,13 +52,42 @@ func NewScheduleResource() resource.Resource {
 type scheduleResource struct {
 	client    temporal.Client
 	namespace string
+	provider  *providerConfig
+}
+
+type scheduleRetryPolicyModel struct {
+	InitialInterval        basetypes.StringValue   `tfsdk:"initial_interval"`
+	MaximumInterval        basetypes.StringValue   `tfsdk:"maximum_interval"`
+	BackoffCoefficient     basetypes.Float64Value  `tfsdk:"backoff_coefficient"`
+	MaximumAttempts        basetypes.Int64Value    `tfsdk:"maximum_attempts"`
+	NonRetryableErrorTypes []basetypes.StringValue `tfsdk:"non_retryable_error_types"`
+}
+
+type scheduleSearchAttributeModel struct {
+	Name  basetypes.StringValue `tfsdk:"name"`
+	Type  basetypes.StringValue `tfsdk:"type"`
+	Value basetypes.StringValue `tfsdk:"value"`
+}
+
+type schedulePayloadModel struct {
+	Data        basetypes.StringValue `tfsdk:"data"`
+	Encoding    basetypes.StringValue `tfsdk:"encoding"`
+	MessageType basetypes.StringValue `tfsdk:"message_type"`
 }
 
 type scheduleActionModel struct {
-	InputPayload  basetypes.StringValue `tfsdk:"input_payload"`
-	WorkflowId    basetypes.StringValue `tfsdk:"workflow_id"`
-	WorkflowType  basetypes.StringValue `tfsdk:"workflow_type"`
-	TaskQueueName basetypes.StringValue `tfsdk:"task_queue_name"`
+	InputPayload             basetypes.StringValue          `tfsdk:"input_payload"`
+	InputPayloads            []basetypes.StringValue        `tfsdk:"input_payloads"`
+	Inputs                   []schedulePayloadModel         `tfsdk:"input"`
+	WorkflowId               basetypes.StringValue          `tfsdk:"workflow_id"`
+	WorkflowType             basetypes.StringValue          `tfsdk:"workflow_type"`
+	TaskQueueName            basetypes.StringValue          `tfsdk:"task_queue_name"`
+	WorkflowExecutionTimeout basetypes.StringValue          `tfsdk:"workflow_execution_timeout"`
+	WorkflowRunTimeout       basetypes.StringValue          `tfsdk:"workflow_run_timeout"`
+	WorkflowTaskTimeout      basetypes.StringValue          `tfsdk:"workflow_task_timeout"`
+	RetryPolicy              *scheduleRetryPolicyModel      `tfsdk:"retry_policy"`
+	Memo                     map[string]string              `tfsdk:"memo"`
+	SearchAttributes         []scheduleSearchAttributeModel `tfsdk:"search_attributes"`
 }
 
 type scheduleIntervalModel struct {
@@ -52,18 +95,45 @@ type scheduleIntervalModel struct {
 	Offset basetypes.StringValue `tfsdk:"offset"`
 }
 
+type scheduleRangeModel struct {
+	Start basetypes.Int64Value `tfsdk:"start"`
+	End   basetypes.Int64Value `tfsdk:"end"`
+	Step  basetypes.Int64Value `tfsdk:"step"`
+}
+
+type scheduleCalendarModel struct {
+	Second     []scheduleRangeModel  `tfsdk:"second"`
+	Minute     []scheduleRangeModel  `tfsdk:"minute"`
+	Hour       []scheduleRangeModel  `tfsdk:"hour"`
+	DayOfMonth []scheduleRangeModel  `tfsdk:"day_of_month"`
+	Month      []scheduleRangeModel  `tfsdk:"month"`
+	Year       []scheduleRangeModel  `tfsdk:"year"`
+	DayOfWeek  []scheduleRangeModel  `tfsdk:"day_of_week"`
+	Comment    basetypes.StringValue `tfsdk:"comment"`
+}
+
 type scheduleSpecModel struct {
-	Intervals []scheduleIntervalModel `tfsdk:"interval"`
+	Intervals       []scheduleIntervalModel `tfsdk:"interval"`
+	CronExpressions []basetypes.StringValue `tfsdk:"cron_expressions"`
+	Calendars       []scheduleCalendarModel `tfsdk:"calendar"`
+	Skip            []scheduleCalendarModel `tfsdk:"skip"`
+	TimeZone        basetypes.StringValue   `tfsdk:"time_zone"`
+	StartAt         basetypes.StringValue   `tfsdk:"start_at"`
+	EndAt           basetypes.StringValue   `tfsdk:"end_at"`
+	Jitter          basetypes.StringValue   `tfsdk:"jitter"`
 }
 
 type scheduleResourceModel struct {
-	Name           basetypes.StringValue `tfsdk:"name"`
-	IsPaused       basetypes.BoolValue   `tfsdk:"is_paused"`
-	Action         scheduleActionModel   `tfsdk:"action"`
-	OverlapPolicy  basetypes.StringValue `tfsdk:"overlap_policy"`
-	CatchupWindow  basetypes.StringValue `tfsdk:"catchup_window"`
-	PauseOnFailure basetypes.BoolValue   `tfsdk:"pause_on_failure"`
-	Spec           scheduleSpecModel     `tfsdk:"spec"`
+	Name               basetypes.StringValue `tfsdk:"name"`
+	Namespace          basetypes.StringValue `tfsdk:"namespace"`
+	IsPaused           basetypes.BoolValue   `tfsdk:"is_paused"`
+	Action             scheduleActionModel   `tfsdk:"action"`
+	OverlapPolicy      basetypes.StringValue `tfsdk:"overlap_policy"`
+	CatchupWindow      basetypes.StringValue `tfsdk:"catchup_window"`
+	PauseOnFailure     basetypes.BoolValue   `tfsdk:"pause_on_failure"`
+	Spec               scheduleSpecModel     `tfsdk:"spec"`
+	DeletionProtection basetypes.BoolValue   `tfsdk:"deletion_protection"`
+	Timeouts           timeouts.Value        `tfsdk:"timeouts"`
 }
 
 func stringToScheduleOverlapPolicy(v string) enums.ScheduleOverlapPolicy {
@@ -103,11 +173,15 @@ func scheduleOverlapPolicyToString(p enums.ScheduleOverlapPolicy) string {
 	return "unspecified"
 }
 
-func parseScheduleResource(name string, response *workflowservice.DescribeScheduleResponse) *scheduleResourceModel {
-	actionDetails := response.GetSchedule().GetAction().GetStartWorkflow()
+func parseScheduleResource(namespace string, name string, response *workflowservice.DescribeScheduleResponse, codec converter.PayloadCodec) (*scheduleResourceModel, error) {
+	action, err := parseScheduleAction(response.GetSchedule().GetAction().GetStartWorkflow(), codec)
+	if err != nil {
+		return nil, err
+	}
+	spec := response.GetSchedule().GetSpec()
 
 	intervals := make([]scheduleIntervalModel, 0)
-	for _, i := range response.GetSchedule().GetSpec().GetInterval() {
+	for _, i := range spec.GetInterval() {
 		intervals = append(intervals, scheduleIntervalModel{
 			Every:  types.StringValue(formatDuration(i.GetInterval().AsDuration())),
 			Offset: types.StringValue(formatDuration(i.GetPhase().AsDuration())),
@@ -123,27 +197,24 @@ func parseScheduleResource(name string, response *workflowservice.DescribeSchedu
 		return intervals[i].Offset.ValueString() < intervals[j].Offset.ValueString()
 	})
 
-	inputPayload := basetypes.StringValue{}
-	if len(actionDetails.GetInput().GetPayloads()) > 0 {
-		inputPayload = types.StringValue(string(actionDetails.GetInput().GetPayloads()[0].GetData()))
-	}
-
 	return &scheduleResourceModel{
 		Name:           types.StringValue(name),
+		Namespace:      types.StringValue(namespace),
 		IsPaused:       types.BoolValue(response.GetSchedule().GetState().GetPaused()),
 		PauseOnFailure: types.BoolValue(response.GetSchedule().GetPolicies().GetPauseOnFailure()),
-		Action: scheduleActionModel{
-			InputPayload:  inputPayload,
-			WorkflowId:    types.StringValue(actionDetails.GetWorkflowId()),
-			WorkflowType:  types.StringValue(actionDetails.GetWorkflowType().GetName()),
-			TaskQueueName: types.StringValue(actionDetails.GetTaskQueue().GetName()),
-		},
+		Action:         action,
 		Spec: scheduleSpecModel{
 			Intervals: intervals,
+			Calendars: parseScheduleCalendars(spec.GetStructuredCalendar(), scheduleCalendarDefaults),
+			Skip:      parseScheduleCalendars(spec.GetExcludeStructuredCalendar(), scheduleSkipDefaults),
+			TimeZone:  optionalStringValue(spec.GetTimezoneName()),
+			StartAt:   parseScheduleTimestamp(spec.GetStartTime()),
+			EndAt:     parseScheduleTimestamp(spec.GetEndTime()),
+			Jitter:    types.StringValue(formatDuration(spec.GetJitter().AsDuration())),
 		},
 		OverlapPolicy: types.StringValue(scheduleOverlapPolicyToString(response.GetSchedule().Policies.OverlapPolicy)),
 		CatchupWindow: types.StringValue(formatDuration(response.GetSchedule().GetPolicies().CatchupWindow.AsDuration())),
-	}
+	}, nil
 }
 
 func (r *scheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -164,6 +235,16 @@ func (r *scheduleResource) Configure(ctx context.Context, req resource.Configure
 
 	r.client = opts.client
 	r.namespace = opts.namespace
+	r.provider = opts
+}
+
+// scheduleNamespace returns the namespace a schedule lives in, falling back to
+// the provider namespace when none is set.
+func (r *scheduleResource) scheduleNamespace(namespace basetypes.StringValue) string {
+	if namespace.IsNull() || namespace.IsUnknown() || namespace.ValueString() == "" {
+		return r.namespace
+	}
+	return namespace.ValueString()
 }
 
 // Metadata returns the resource type name.
@@ -172,7 +253,7 @@ func (r *scheduleResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *scheduleResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "The `temporal_schedule` resource allows you to create and manage schedules for Temporal workflows. A schedule in Temporal defines when and how frequently a workflow should be executed.",
 		Attributes: map[string]schema.Attribute{
@@ -183,6 +264,15 @@ func (r *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 				Required: true,
 			},
+			"namespace": schema.StringAttribute{
+				Description: "Namespace the schedule belongs to. Defaults to the provider namespace.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"is_paused": schema.BoolAttribute{
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
@@ -195,6 +285,12 @@ func (r *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Description: "Whether that schedule should be paused after a failure.",
 				Optional:    true,
 			},
+			"deletion_protection": schema.BoolAttribute{
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Whether the schedule is protected from deletion. While set, destroying the schedule fails, set it to `false` and apply first. Defaults to `false`.",
+				Optional:            true,
+			},
 			"overlap_policy": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Controls what happens when an Action would be started by a Schedule at the same time that an older Action is still running. One of: `skip`, `buffer_one`, `buffer_all`, `cancel_other`, `terminate_other`, `allow_all`.",
@@ -236,14 +332,164 @@ func (r *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 						Required:    true,
 					},
 					"input_payload": schema.StringAttribute{
-						Description: "Input payload passed to the workflow execution. Must be a valid JSON string.",
+						MarkdownDescription: "Single argument passed to the workflow execution. Must be a valid JSON string, of any JSON type. Shorthand for an `input_payloads` with a single element.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("input_payloads"),
+								path.MatchRelative().AtParent().AtName("input"),
+							),
+						},
+					},
+					"input_payloads": schema.ListAttribute{
+						ElementType:         types.StringType,
+						MarkdownDescription: "Arguments passed to the workflow execution, one per positional argument. Each element must be a valid JSON string. Use `input` blocks for other encodings.",
+						Optional:            true,
+						Validators: []validator.List{
+							listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("input")),
+						},
+					},
+					"workflow_execution_timeout": schema.StringAttribute{
+						Description: "Timeout for the whole workflow execution, including retries and continue-as-new. E.g \"1h\", \"7d\". \"0s\" means no timeout.",
 						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							validators.StringDurationValidator{},
+						},
+					},
+					"workflow_run_timeout": schema.StringAttribute{
+						Description: "Timeout for a single workflow run. E.g \"1h\", \"7d\". \"0s\" means no timeout.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							validators.StringDurationValidator{},
+						},
+					},
+					"workflow_task_timeout": schema.StringAttribute{
+						Description: "Timeout for processing a workflow task from the time the worker pulled it. E.g \"10s\". \"0s\" means the server default.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							validators.StringDurationValidator{},
+						},
+					},
+					"memo": schema.MapAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Description: "Non-indexed information attached to the started workflows. Values must be valid JSON strings.",
+					},
+					"search_attributes": schema.SetNestedAttribute{
+						MarkdownDescription: "Typed search attributes set on the started workflows. The attributes must be registered on the namespace.",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"name": schema.StringAttribute{
+									Description: "Search attribute name.",
+									Required:    true,
+								},
+								"type": schema.StringAttribute{
+									MarkdownDescription: "Search attribute type. One of: `Text`, `Keyword`, `Int`, `Double`, `Bool`, `Datetime`, `KeywordList`.",
+									Required:            true,
+									Validators: []validator.String{
+										validators.StringInSliceValidator{
+											AllowedValues: searchAttributeTypes,
+										},
+									},
+								},
+								"value": schema.StringAttribute{
+									MarkdownDescription: "Search attribute value. `Datetime` values must be RFC3339 timestamps and `KeywordList` values JSON arrays of strings, e.g. `jsonencode([\"a\", \"b\"])`.",
+									Required:            true,
+								},
+							},
+						},
 					},
 				},
-				Description: "Details about the action this schedule triggers.",
-				PlanModifiers: []planmodifier.Object{
-					objectplanmodifier.RequiresReplace(),
+				Blocks: map[string]schema.Block{
+					"input": schema.ListNestedBlock{
+						MarkdownDescription: "Arguments passed to the workflow execution, one per positional argument, with an explicit payload encoding. Alternative to `input_payload` and `input_payloads`.",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"data": schema.StringAttribute{
+									MarkdownDescription: "Payload data. A JSON string for `json/plain` and `json/protobuf`, base64 encoded bytes for `binary/plain`, and unset for `binary/null`.",
+									Optional:            true,
+								},
+								"encoding": schema.StringAttribute{
+									MarkdownDescription: "Payload encoding. One of: `json/plain`, `binary/plain`, `json/protobuf`, `binary/null`.",
+									Optional:            true,
+									Computed:            true,
+									Default:             stringdefault.StaticString("json/plain"),
+									Validators: []validator.String{
+										validators.StringInSliceValidator{
+											AllowedValues: payloadEncodings,
+										},
+									},
+								},
+								"message_type": schema.StringAttribute{
+									MarkdownDescription: "Fully qualified protobuf message type of the payload, e.g. `my.package.MyMessage`. Required for the `json/protobuf` encoding.",
+									Optional:            true,
+								},
+							},
+						},
+					},
+					"retry_policy": schema.SingleNestedBlock{
+						Description: "Retry policy of the started workflows. Workflows are not retried by default.",
+						Attributes: map[string]schema.Attribute{
+							"initial_interval": schema.StringAttribute{
+								Description: "Backoff interval for the first retry. E.g \"1s\".",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+								Validators: []validator.String{
+									validators.StringDurationValidator{},
+								},
+							},
+							"maximum_interval": schema.StringAttribute{
+								Description: "Maximum backoff interval between retries. E.g \"10m\".",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.UseStateForUnknown(),
+								},
+								Validators: []validator.String{
+									validators.StringDurationValidator{},
+								},
+							},
+							"backoff_coefficient": schema.Float64Attribute{
+								Description: "Coefficient used to calculate the next retry backoff interval.",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.Float64{
+									float64planmodifier.UseStateForUnknown(),
+								},
+							},
+							"maximum_attempts": schema.Int64Attribute{
+								Description: "Maximum number of attempts. 0 means unlimited.",
+								Optional:    true,
+								Computed:    true,
+								PlanModifiers: []planmodifier.Int64{
+									int64planmodifier.UseStateForUnknown(),
+								},
+							},
+							"non_retryable_error_types": schema.ListAttribute{
+								ElementType: types.StringType,
+								Optional:    true,
+								Description: "Application error types that are not retried.",
+							},
+						},
+					},
 				},
+				Description: "Details about the action this schedule triggers.",
 				Validators: []validator.Object{
 					objectvalidator.IsRequired(),
 				},
@@ -253,7 +499,49 @@ func (r *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Validators: []validator.Object{
 					objectvalidator.IsRequired(),
 				},
+				Attributes: map[string]schema.Attribute{
+					"cron_expressions": schema.ListAttribute{
+						ElementType:         types.StringType,
+						Optional:            true,
+						MarkdownDescription: "Cron-based specifications of times, e.g. `0 3 * * MON-FRI`. Expressions can have 5 (minute to day of week), 6 (with year) or 7 (with second and year) fields, or be one of `@yearly`, `@monthly`, `@weekly`, `@daily`, `@hourly` and `@every <interval>[/<offset>]`. The server translates them into calendar specifications, which is how they show up once imported.",
+						Validators: []validator.List{
+							listvalidator.ValueStringsAre(validators.StringCronValidator{}),
+						},
+					},
+					"time_zone": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "IANA time zone name the calendars and cron expressions are evaluated in, e.g. `Europe/London`. Defaults to UTC.",
+						Validators: []validator.String{
+							validators.StringTimeZoneValidator{},
+						},
+					},
+					"start_at": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "RFC3339 timestamp before which no action is taken, e.g. `2025-06-01T00:00:00Z`.",
+						Validators: []validator.String{
+							validators.StringRFC3339Validator{},
+						},
+					},
+					"end_at": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: "RFC3339 timestamp after which no action is taken, e.g. `2025-09-01T00:00:00Z`.",
+						Validators: []validator.String{
+							validators.StringRFC3339Validator{},
+						},
+					},
+					"jitter": schema.StringAttribute{
+						Optional:    true,
+						Computed:    true,
+						Default:     stringdefault.StaticString("0s"),
+						Description: "Random delay added to each action time, up to this amount, to spread the load of many schedules. E.g \"30s\", \"5m\".",
+						Validators: []validator.String{
+							validators.StringDurationValidator{},
+						},
+					},
+				},
 				Blocks: map[string]schema.Block{
+					"calendar": scheduleCalendarBlock("Calendar-based specifications of times, similar to a traditional cron specification. A time matches if at least one range of each field matches.", false),
+					"skip":     scheduleCalendarBlock("Calendar-based specifications of times to skip, e.g. bank holidays or freeze windows. A time is skipped if at least one range of each field matches. Omitted fields match all values, so a skip calendar with only a day skips the whole day.", true),
 					"interval": schema.SetNestedBlock{
 						Description: "Interval-based specifications of times. Allows to run a workflow \"every X seconds|minutes|hours|days\".",
 						NestedObject: schema.NestedBlockObject{
@@ -279,6 +567,12 @@ func (r *scheduleResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					},
 				},
 			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -293,38 +587,15 @@ func (r *scheduleResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	var args = make([]interface{}, 0)
-	if !data.Action.InputPayload.IsNull() {
-		var d map[string]interface{}
-		argsString := data.Action.InputPayload.ValueString()
-		err := json.Unmarshal([]byte(argsString), &d)
-		if err != nil {
-			resp.Diagnostics.AddError("Invalid input_payload", err.Error())
-			return
-		}
-		args = []interface{}{d}
-	}
+	namespace := r.scheduleNamespace(data.Namespace)
+	codec := r.provider.codec.payloadCodec(namespace)
 
-	intervals := make([]temporal.ScheduleIntervalSpec, 0)
-	for _, i := range data.Spec.Intervals {
-		every, err := parseDuration(i.Every.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("Error parsing schedule interval value", i.Every.ValueString())
-			continue
-		}
-		var offset time.Duration
-		if i.Offset.ValueString() != "" {
-			offset, err = parseDuration(i.Offset.ValueString())
-			if err != nil {
-				resp.Diagnostics.AddError("Error parsing schedule offset value", i.Offset.ValueString())
-				continue
-			}
-		}
-		intervals = append(intervals, temporal.ScheduleIntervalSpec{
-			Every:  every,
-			Offset: offset,
-		})
-	}
+	createTimeout, diags := data.Timeouts.Create(ctx, scheduleCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	action, diags := data.Action.scheduleWorkflowAction(codec)
+	resp.Diagnostics.Append(diags...)
+	spec, diags := data.Spec.scheduleSpec()
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -335,23 +606,18 @@ func (r *scheduleResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
-	// Create the schedule action
-	action := &temporal.ScheduleWorkflowAction{
-		Workflow:  data.Action.WorkflowType.ValueString(),
-		TaskQueue: data.Action.TaskQueueName.ValueString(),
-		Args:      args,
+	nsClient, err := r.provider.namespaceClient(namespace)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create a Temporal client for namespace "+namespace, err.Error())
+		return
 	}
 
-	// Only set the workflow ID if it's provided in the configuration
-	if !data.Action.WorkflowId.IsNull() && !data.Action.WorkflowId.IsUnknown() {
-		action.ID = data.Action.WorkflowId.ValueString()
-	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-	_, err = r.client.ScheduleClient().Create(ctx, temporal.ScheduleOptions{
-		ID: data.Name.ValueString(),
-		Spec: temporal.ScheduleSpec{
-			Intervals: intervals,
-		},
+	_, err = nsClient.ScheduleClient().Create(withSDKRetries(ctx), temporal.ScheduleOptions{
+		ID:             data.Name.ValueString(),
+		Spec:           *spec,
 		Action:         action,
 		Overlap:        stringToScheduleOverlapPolicy(data.OverlapPolicy.ValueString()),
 		CatchupWindow:  catchupWindow,
@@ -359,24 +625,33 @@ func (r *scheduleResource) Create(ctx context.Context, req resource.CreateReques
 		Paused:         data.IsPaused.ValueBool(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating schedule", err.Error())
+		resp.Diagnostics.AddError("Error creating schedule", serviceErrorDetail(err))
 		return
 	}
 
 	// After creating the schedule, we need to fetch it to get the computed values
 	// but preserve the original workflow_id since Temporal uses it as a template
 	schedule, err := r.client.WorkflowService().DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
-		Namespace:  r.namespace,
+		Namespace:  namespace,
 		ScheduleId: data.Name.ValueString(),
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to fetch the Temporal schedule after creation.", err.Error())
+		resp.Diagnostics.AddError("Unable to fetch the Temporal schedule after creation.", serviceErrorDetail(err))
 		return
 	}
 
 	// Parse the response - always use the value returned from Temporal as source of truth
-	parsedData := parseScheduleResource(data.Name.ValueString(), schedule)
+	parsedData, err := parseScheduleResource(namespace, data.Name.ValueString(), schedule, codec)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read the Temporal schedule after creation.", err.Error())
+		return
+	}
+
+	parsedData.Spec.matchConfigured(data.Spec)
+	parsedData.Action.matchConfigured(data.Action)
+	parsedData.DeletionProtection = data.DeletionProtection
+	parsedData.Timeouts = data.Timeouts
 
 	// If workflow_id was explicitly provided in the configuration, preserve it
 	// Otherwise, use the auto-generated one from Temporal
@@ -387,7 +662,7 @@ func (r *scheduleResource) Create(ctx context.Context, req resource.CreateReques
 
 	data = parsedData
 
-	diags := resp.State.Set(ctx, &data)
+	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -397,28 +672,69 @@ func (r *scheduleResource) Create(ctx context.Context, req resource.CreateReques
 // Read refreshes the Terraform state with the latest data.
 func (r *scheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var name string
+	var namespaceValue basetypes.StringValue
+	var priorSpec *scheduleSpecModel
+	var priorAction *scheduleActionModel
+	var deletionProtection basetypes.BoolValue
+	var timeoutsValue timeouts.Value
 
 	diags := req.State.GetAttribute(ctx, path.Root("name"), &name)
 	resp.Diagnostics.Append(diags...)
+	diags = req.State.GetAttribute(ctx, path.Root("namespace"), &namespaceValue)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.GetAttribute(ctx, path.Root("spec"), &priorSpec)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.GetAttribute(ctx, path.Root("action"), &priorAction)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.GetAttribute(ctx, path.Root("timeouts"), &timeoutsValue)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	namespace := r.scheduleNamespace(namespaceValue)
+
+	readTimeout, diags := timeoutsValue.Read(ctx, scheduleReadTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	schedule, err := r.client.WorkflowService().DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
-		Namespace:  r.namespace,
+		Namespace:  namespace,
 		ScheduleId: name,
 	})
 
 	if err != nil {
-		if err.Error() == "schedule not found" {
+		if isNotFound(err) {
 			resp.State.RemoveResource(ctx)
 		} else {
-			resp.Diagnostics.AddError("Error fetching the Schedule "+name, err.Error())
+			resp.Diagnostics.AddError("Error fetching the Schedule "+name, serviceErrorDetail(err))
 		}
 		return
 	}
 
-	data := parseScheduleResource(name, schedule)
+	data, err := parseScheduleResource(namespace, name, schedule, r.provider.codec.payloadCodec(namespace))
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading the Schedule "+name, err.Error())
+		return
+	}
+	if priorSpec != nil {
+		data.Spec.matchConfigured(*priorSpec)
+	}
+	if priorAction != nil {
+		data.Action.matchConfigured(*priorAction)
+	}
+	// Imported schedules are not protected, as the default.
+	if deletionProtection.IsNull() {
+		deletionProtection = types.BoolValue(false)
+	}
+	data.DeletionProtection = deletionProtection
+	data.Timeouts = timeoutsValue
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -444,26 +760,15 @@ func (r *scheduleResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	intervals := make([]temporal.ScheduleIntervalSpec, 0)
-	for _, i := range data.Spec.Intervals {
-		every, err := parseDuration(i.Every.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("Error parsing schedule interval value", i.Every.ValueString())
-			continue
-		}
-		var offset time.Duration
-		if i.Offset.ValueString() != "" {
-			offset, err = parseDuration(i.Offset.ValueString())
-			if err != nil {
-				resp.Diagnostics.AddError("Error parsing schedule offset value", i.Offset.ValueString())
-				continue
-			}
-		}
-		intervals = append(intervals, temporal.ScheduleIntervalSpec{
-			Every:  every,
-			Offset: offset,
-		})
-	}
+	namespace := r.scheduleNamespace(data.Namespace)
+	codec := r.provider.codec.payloadCodec(namespace)
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, scheduleUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	action, diags := data.Action.scheduleWorkflowAction(codec)
+	resp.Diagnostics.Append(diags...)
+	spec, diags := data.Spec.scheduleSpec()
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -474,37 +779,57 @@ func (r *scheduleResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
-	handle := r.client.ScheduleClient().GetHandle(ctx, name)
-	err = handle.Update(ctx, temporal.ScheduleUpdateOptions{
+	nsClient, err := r.provider.namespaceClient(namespace)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to create a Temporal client for namespace "+namespace, err.Error())
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	handle := nsClient.ScheduleClient().GetHandle(ctx, name)
+	err = handle.Update(withSDKRetries(ctx), temporal.ScheduleUpdateOptions{
 		DoUpdate: func(i temporal.ScheduleUpdateInput) (*temporal.ScheduleUpdate, error) {
 			i.Description.Schedule.State.Paused = data.IsPaused.ValueBool()
 			i.Description.Schedule.Policy.PauseOnFailure = data.PauseOnFailure.ValueBool()
 			i.Description.Schedule.Policy.Overlap = stringToScheduleOverlapPolicy(data.OverlapPolicy.ValueString())
 			i.Description.Schedule.Policy.CatchupWindow = catchupWindow
-			i.Description.Schedule.Spec = &temporal.ScheduleSpec{
-				Intervals: intervals,
-			}
+			i.Description.Schedule.Spec = spec
+			i.Description.Schedule.Action = action
 			return &temporal.ScheduleUpdate{
 				Schedule: &i.Description.Schedule,
 			}, nil
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating the Schedule "+name, err.Error())
+		resp.Diagnostics.AddError("Error updating the Schedule "+name, serviceErrorDetail(err))
 		return
 	}
 
 	schedule, err := r.client.WorkflowService().DescribeSchedule(ctx, &workflowservice.DescribeScheduleRequest{
-		Namespace:  r.namespace,
+		Namespace:  namespace,
 		ScheduleId: name,
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error fetching the Schedule "+name, err.Error())
+		resp.Diagnostics.AddError("Error fetching the Schedule "+name, serviceErrorDetail(err))
 		return
 	}
 
-	data = parseScheduleResource(name, schedule)
+	plannedSpec := data.Spec
+	plannedAction := data.Action
+	plannedDeletionProtection := data.DeletionProtection
+	plannedTimeouts := data.Timeouts
+	data, err = parseScheduleResource(namespace, name, schedule, codec)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading the Schedule "+name, err.Error())
+		return
+	}
+	data.Spec.matchConfigured(plannedSpec)
+	data.Action.matchConfigured(plannedAction)
+	data.DeletionProtection = plannedDeletionProtection
+	data.Timeouts = plannedTimeouts
 
 	diags = resp.State.Set(ctx, &data)
 	resp.Diagnostics.Append(diags...)
@@ -524,16 +849,51 @@ func (r *scheduleResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Schedule "+data.Name.ValueString()+" is protected from deletion",
+			"Set deletion_protection to false and apply before destroying it.",
+		)
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, scheduleDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	_, err := r.client.WorkflowService().DeleteSchedule(ctx, &workflowservice.DeleteScheduleRequest{
 		ScheduleId: data.Name.ValueString(),
-		Namespace:  r.namespace,
+		Namespace:  r.scheduleNamespace(data.Namespace),
 	})
+	// The schedule is already gone, nothing to delete.
+	if isNotFound(err) {
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Error while deleting schedule "+data.Name.ValueString(), err.Error())
+		resp.Diagnostics.AddError("Error while deleting schedule "+data.Name.ValueString(), serviceErrorDetail(err))
 		return
 	}
 }
 
+// ImportState imports a schedule either by its name, in the provider namespace,
+// or as "namespace/name". A schedule whose name contains "/" must be imported
+// in the "namespace/name" form.
 func (r *scheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	namespace, name := splitImportID(req.ID, r.namespace)
+	if namespace == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"Expected an import ID of the form \"schedule_id\" or \"namespace/schedule_id\", got: "+req.ID+". "+
+				"A schedule ID containing \"/\" must be prefixed with its namespace.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
 }
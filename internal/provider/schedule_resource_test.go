@@ -1,9 +1,16 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 )
 
 func TestAccOrderResource(t *testing.T) {
@@ -37,6 +44,7 @@ resource "temporal_schedule" "example" {
 }`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("temporal_schedule.example", "name", "Example Schedule"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "namespace", "default"),
 					resource.TestCheckResourceAttr("temporal_schedule.example", "is_paused", "true"),
 					resource.TestCheckResourceAttr("temporal_schedule.example", "pause_on_failure", "true"),
 					resource.TestCheckResourceAttr("temporal_schedule.example", "overlap_policy", "skip"),
@@ -49,11 +57,129 @@ resource "temporal_schedule" "example" {
 					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.interval.0.offset", "1h"),
 				),
 			},
-			// Create and Read testing - required fields only
+			// Update testing - workflow start options
 			{
 				Config: testProviderConfig + `
 resource "temporal_schedule" "example" {
   name             = "Example Schedule"
+  is_paused        = true
+
+  action {
+    workflow_type              = "exampleWorkflow"
+    task_queue_name            = "example-task-queue"
+    input_payloads             = [jsonencode("abc"), jsonencode(42), jsonencode([1, 2])]
+    workflow_execution_timeout = "7d"
+    workflow_run_timeout       = "1d"
+    workflow_task_timeout      = "10s"
+    memo = {
+      owner = jsonencode("team-a")
+    }
+    search_attributes = [
+      {
+        name  = "CustomKeywordField"
+        type  = "Keyword"
+        value = "abc"
+      },
+      {
+        name  = "CustomIntField"
+        type  = "Int"
+        value = "42"
+      },
+    ]
+
+    retry_policy {
+      initial_interval          = "1s"
+      maximum_interval          = "1m"
+      backoff_coefficient       = 2
+      maximum_attempts          = 5
+      non_retryable_error_types = ["InvalidInput"]
+    }
+  }
+
+  spec {
+    interval {
+      every  = "1d"
+    }
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("temporal_schedule.example", "action.input_payload"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.input_payloads.#", "3"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.input_payloads.0", "\"abc\""),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.input_payloads.1", "42"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.input_payloads.2", "[1,2]"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.workflow_execution_timeout", "7d"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.workflow_run_timeout", "1d"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.workflow_task_timeout", "10s"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.memo.owner", "\"team-a\""),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.search_attributes.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("temporal_schedule.example", "action.search_attributes.*", map[string]string{
+						"name":  "CustomIntField",
+						"type":  "Int",
+						"value": "42",
+					}),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.retry_policy.initial_interval", "1s"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.retry_policy.maximum_interval", "1m"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.retry_policy.backoff_coefficient", "2"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.retry_policy.maximum_attempts", "5"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.retry_policy.non_retryable_error_types.0", "InvalidInput"),
+				),
+			},
+			// Update testing - explicit payload encodings
+			{
+				Config: testProviderConfig + `
+resource "temporal_schedule" "example" {
+  name             = "Example Schedule"
+  is_paused        = true
+
+  action {
+    workflow_type   = "exampleWorkflow"
+    task_queue_name = "example-task-queue"
+
+    input {
+      data = jsonencode({ myVar = "abc" })
+    }
+    input {
+      data     = base64encode("raw bytes")
+      encoding = "binary/plain"
+    }
+    input {
+      data         = jsonencode({ id = "123" })
+      encoding     = "json/protobuf"
+      message_type = "example.v1.Request"
+    }
+    input {
+      encoding = "binary/null"
+    }
+  }
+
+  spec {
+    interval {
+      every  = "1d"
+    }
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.input.#", "4"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.input.0.encoding", "json/plain"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.input.0.data", "{\"myVar\":\"abc\"}"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.input.1.encoding", "binary/plain"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.input.1.data", "cmF3IGJ5dGVz"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.input.2.message_type", "example.v1.Request"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "action.input.3.encoding", "binary/null"),
+					resource.TestCheckNoResourceAttr("temporal_schedule.example", "action.input.3.data"),
+				),
+			},
+			// Update testing - required fields only, the action is updated in place
+			{
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("temporal_schedule.example", plancheck.ResourceActionUpdate),
+					},
+				},
+				Config: testProviderConfig + `
+resource "temporal_schedule" "example" {
+  name             = "Example Schedule"
 
   action {
     workflow_type   = "exampleWorkflow"
@@ -111,7 +237,173 @@ resource "temporal_schedule" "example" {
 					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.interval.0.offset", "0s"),
 				),
 			},
+			// Update testing - cron expressions
+			{
+				Config: testProviderConfig + `
+resource "temporal_schedule" "example" {
+  name             = "Example Schedule Auto ID"
+
+  action {
+    workflow_type   = "exampleWorkflow"
+    task_queue_name = "example-task-queue"
+  }
+
+  spec {
+    cron_expressions = ["0 3 * * MON-FRI", "@daily"]
+  }
+
+  timeouts {
+    update = "2m"
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.cron_expressions.#", "2"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.cron_expressions.0", "0 3 * * MON-FRI"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.cron_expressions.1", "@daily"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.interval.#", "0"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "timeouts.update", "2m"),
+				),
+			},
+			// Update testing - calendars
+			{
+				Config: testProviderConfig + `
+resource "temporal_schedule" "example" {
+  name             = "Example Schedule Auto ID"
+
+  action {
+    workflow_type   = "exampleWorkflow"
+    task_queue_name = "example-task-queue"
+  }
+
+  spec {
+    time_zone = "Europe/London"
+    start_at  = "2025-01-01T00:00:00Z"
+    end_at    = "2035-01-01T00:00:00Z"
+    jitter    = "5m"
+
+    calendar {
+      comment = "Second Tuesday of every quarter at 09:30"
+      hour {
+        start = 9
+      }
+      minute {
+        start = 30
+      }
+      day_of_month {
+        start = 8
+        end   = 14
+      }
+      month {
+        start = 1
+        end   = 12
+        step  = 3
+      }
+      day_of_week {
+        start = 2
+      }
+    }
+
+    skip {
+      comment = "Christmas day"
+      day_of_month {
+        start = 25
+      }
+      month {
+        start = 12
+      }
+    }
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.time_zone", "Europe/London"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.start_at", "2025-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.end_at", "2035-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.jitter", "5m"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.calendar.#", "1"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.calendar.0.comment", "Second Tuesday of every quarter at 09:30"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.calendar.0.hour.0.start", "9"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.calendar.0.month.0.step", "3"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.calendar.0.day_of_week.0.step", "1"),
+					resource.TestCheckNoResourceAttr("temporal_schedule.example", "spec.calendar.0.day_of_week.0.end"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.skip.#", "1"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.skip.0.comment", "Christmas day"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.skip.0.month.0.start", "12"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.skip.0.hour.#", "0"),
+					resource.TestCheckResourceAttr("temporal_schedule.example", "spec.cron_expressions.#", "0"),
+				),
+			},
+			// ImportState testing - namespace/name form
+			{
+				ResourceName:                         "temporal_schedule.example",
+				ImportState:                          true,
+				ImportStateId:                        "default/Example Schedule Auto ID",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
+
+// importState runs the import of id into an empty state of r, and returns the
+// imported namespace and name.
+func importState(t *testing.T, r fwresource.ResourceWithImportState, id string) (string, string, diag.Diagnostics) {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+	resp := &fwresource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: id}, resp)
+	if resp.Diagnostics.HasError() {
+		return "", "", resp.Diagnostics
+	}
+
+	var namespace, name string
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("namespace"), &namespace)...)
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("name"), &name)...)
+	return namespace, name, resp.Diagnostics
+}
+
+func TestScheduleImportState(t *testing.T) {
+	tests := []struct {
+		id            string
+		wantNamespace string
+		wantName      string
+		wantErr       bool
+	}{
+		{id: "daily-report", wantNamespace: "default", wantName: "daily-report"},
+		{id: "other/daily-report", wantNamespace: "other", wantName: "daily-report"},
+		{id: "other/reports/daily", wantNamespace: "other", wantName: "reports/daily"},
+		{id: "/daily-report", wantErr: true},
+		{id: "other/", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			namespace, name, diags := importState(t, &scheduleResource{namespace: "default"}, tt.id)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if namespace != tt.wantNamespace || name != tt.wantName {
+				t.Errorf("imported %q/%q, want %q/%q", namespace, name, tt.wantNamespace, tt.wantName)
+			}
+		})
+	}
+}
+
+func TestScheduleDeleteProtected(t *testing.T) {
+	r := &scheduleResource{}
+	resp := &fwresource.DeleteResponse{}
+	r.Delete(context.Background(), fwresource.DeleteRequest{
+		State: testResourceState(t, r, map[string]any{"name": "example", "deletion_protection": true}),
+	}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error deleting a protected schedule")
+	}
+}
@@ -0,0 +1,500 @@
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"go.temporal.io/api/schedule/v1"
+	temporal "go.temporal.io/sdk/client"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// scheduleSpec converts the spec model into the client representation.
+func (m scheduleSpecModel) scheduleSpec() (*temporal.ScheduleSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	intervals := make([]temporal.ScheduleIntervalSpec, 0)
+	for _, i := range m.Intervals {
+		every, err := parseDuration(i.Every.ValueString())
+		if err != nil {
+			diags.AddError("Error parsing schedule interval value", i.Every.ValueString())
+			continue
+		}
+		var offset time.Duration
+		if i.Offset.ValueString() != "" {
+			offset, err = parseDuration(i.Offset.ValueString())
+			if err != nil {
+				diags.AddError("Error parsing schedule offset value", i.Offset.ValueString())
+				continue
+			}
+		}
+		intervals = append(intervals, temporal.ScheduleIntervalSpec{
+			Every:  every,
+			Offset: offset,
+		})
+	}
+
+	cronExpressions := make([]string, 0, len(m.CronExpressions))
+	for _, c := range m.CronExpressions {
+		cronExpressions = append(cronExpressions, c.ValueString())
+	}
+
+	calendars := make([]temporal.ScheduleCalendarSpec, 0, len(m.Calendars))
+	for _, c := range m.Calendars {
+		calendars = append(calendars, c.scheduleCalendarSpec(scheduleCalendarDefaults))
+	}
+
+	skip := make([]temporal.ScheduleCalendarSpec, 0, len(m.Skip))
+	for _, c := range m.Skip {
+		skip = append(skip, c.scheduleCalendarSpec(scheduleSkipDefaults))
+	}
+
+	var startAt, endAt time.Time
+	var err error
+	if m.StartAt.ValueString() != "" {
+		startAt, err = time.Parse(time.RFC3339, m.StartAt.ValueString())
+		if err != nil {
+			diags.AddError("Error parsing the schedule start time", m.StartAt.ValueString())
+		}
+	}
+	if m.EndAt.ValueString() != "" {
+		endAt, err = time.Parse(time.RFC3339, m.EndAt.ValueString())
+		if err != nil {
+			diags.AddError("Error parsing the schedule end time", m.EndAt.ValueString())
+		}
+	}
+	if !startAt.IsZero() && !endAt.IsZero() && endAt.Before(startAt) {
+		diags.AddError("Invalid schedule time range", "end_at must not be before start_at.")
+	}
+
+	var jitter time.Duration
+	if m.Jitter.ValueString() != "" {
+		jitter, err = parseDuration(m.Jitter.ValueString())
+		if err != nil {
+			diags.AddError("Error parsing the schedule jitter as a duration", m.Jitter.ValueString())
+		}
+	}
+
+	return &temporal.ScheduleSpec{
+		Calendars:       calendars,
+		Intervals:       intervals,
+		CronExpressions: cronExpressions,
+		Skip:            skip,
+		TimeZoneName:    m.TimeZone.ValueString(),
+		StartAt:         startAt,
+		EndAt:           endAt,
+		Jitter:          jitter,
+	}, diags
+}
+
+// scheduleCalendarBlock describes a calendar-based specification of times. The
+// omitted second, minute and hour fields of a skip calendar match all values.
+func scheduleCalendarBlock(description string, skip bool) schema.SetNestedBlock {
+	secondDefault, minuteDefault, hourDefault := "Defaults to 0.", "Defaults to 0.", "Defaults to 0."
+	if skip {
+		secondDefault, minuteDefault, hourDefault = "Defaults to all seconds.", "Defaults to all minutes.", "Defaults to all hours."
+	}
+
+	return schema.SetNestedBlock{
+		Description: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"comment": schema.StringAttribute{
+					Description: "Description of the intention of this calendar.",
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString(""),
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"second":       scheduleRangeBlock("Seconds to match (0-59). "+secondDefault, 0, 59),
+				"minute":       scheduleRangeBlock("Minutes to match (0-59). "+minuteDefault, 0, 59),
+				"hour":         scheduleRangeBlock("Hours to match (0-23). "+hourDefault, 0, 23),
+				"day_of_month": scheduleRangeBlock("Days of the month to match (1-31). Defaults to all days.", 1, 31),
+				"month":        scheduleRangeBlock("Months to match (1-12). Defaults to all months.", 1, 12),
+				"year":         scheduleRangeBlock("Years to match. Defaults to all years.", 1970, 9999),
+				"day_of_week":  scheduleRangeBlock("Days of the week to match (0-6, 0 is Sunday). Unlike in cron expressions, 7 is not accepted for Sunday. Defaults to all days.", 0, 6),
+			},
+		},
+	}
+}
+
+func scheduleRangeBlock(description string, minValue, maxValue int64) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		Description: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"start": schema.Int64Attribute{
+					Description: "Start of the range (inclusive).",
+					Required:    true,
+					Validators: []validator.Int64{
+						int64validator.Between(minValue, maxValue),
+					},
+				},
+				"end": schema.Int64Attribute{
+					Description: "End of the range (inclusive). Defaults to start.",
+					Optional:    true,
+					Validators: []validator.Int64{
+						int64validator.Between(minValue, maxValue),
+					},
+				},
+				"step": schema.Int64Attribute{
+					Description: "Step between each value of the range.",
+					Optional:    true,
+					Computed:    true,
+					Default:     int64default.StaticInt64(1),
+					Validators: []validator.Int64{
+						int64validator.AtLeast(1),
+					},
+				},
+			},
+		},
+	}
+}
+
+// scheduleCalendarSpec converts the calendar model, setting the omitted fields
+// to the given defaults.
+func (m scheduleCalendarModel) scheduleCalendarSpec(defaults scheduleCalendarModel) temporal.ScheduleCalendarSpec {
+	m = m.withDefaults(defaults)
+	return temporal.ScheduleCalendarSpec{
+		Second:     scheduleRanges(m.Second),
+		Minute:     scheduleRanges(m.Minute),
+		Hour:       scheduleRanges(m.Hour),
+		DayOfMonth: scheduleRanges(m.DayOfMonth),
+		Month:      scheduleRanges(m.Month),
+		Year:       scheduleRanges(m.Year),
+		DayOfWeek:  scheduleRanges(m.DayOfWeek),
+		Comment:    m.Comment.ValueString(),
+	}
+}
+
+// scheduleRanges converts range models, leaving the list nil when empty so
+// that the client applies the field default.
+func scheduleRanges(ranges []scheduleRangeModel) []temporal.ScheduleRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	res := make([]temporal.ScheduleRange, 0, len(ranges))
+	for _, r := range ranges {
+		start := int(r.Start.ValueInt64())
+		end := start
+		if !r.End.IsNull() && !r.End.IsUnknown() {
+			end = int(r.End.ValueInt64())
+		}
+		step := 1
+		if !r.Step.IsNull() && !r.Step.IsUnknown() {
+			step = int(r.Step.ValueInt64())
+		}
+		res = append(res, temporal.ScheduleRange{Start: start, End: end, Step: step})
+	}
+	return res
+}
+
+// parseScheduleCalendars reads calendars back from the server, sorted to keep
+// a stable order between refreshes. The fields set to the given defaults are
+// left empty, as they are when omitted in the configuration.
+func parseScheduleCalendars(calendars []*schedule.StructuredCalendarSpec, defaults scheduleCalendarModel) []scheduleCalendarModel {
+	res := make([]scheduleCalendarModel, 0, len(calendars))
+	for _, c := range calendars {
+		res = append(res, parseScheduleCalendar(c, defaults))
+	}
+
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].sortKey() < res[j].sortKey()
+	})
+
+	return res
+}
+
+func parseScheduleCalendar(c *schedule.StructuredCalendarSpec, defaults scheduleCalendarModel) scheduleCalendarModel {
+	return scheduleCalendarModel{
+		Second:     withoutDefaultRanges(parseScheduleRanges(c.GetSecond()), defaults.Second),
+		Minute:     withoutDefaultRanges(parseScheduleRanges(c.GetMinute()), defaults.Minute),
+		Hour:       withoutDefaultRanges(parseScheduleRanges(c.GetHour()), defaults.Hour),
+		DayOfMonth: withoutDefaultRanges(parseScheduleRanges(c.GetDayOfMonth()), defaults.DayOfMonth),
+		Month:      withoutDefaultRanges(parseScheduleRanges(c.GetMonth()), defaults.Month),
+		Year:       withoutDefaultRanges(parseScheduleRanges(c.GetYear()), defaults.Year),
+		DayOfWeek:  withoutDefaultRanges(parseScheduleRanges(c.GetDayOfWeek()), defaults.DayOfWeek),
+		Comment:    types.StringValue(c.GetComment()),
+	}
+}
+
+func parseScheduleRanges(ranges []*schedule.Range) []scheduleRangeModel {
+	if len(ranges) == 0 {
+		return nil
+	}
+	res := make([]scheduleRangeModel, 0, len(ranges))
+	for _, r := range ranges {
+		// The server leaves end and step unset for single values.
+		end := types.Int64Null()
+		if r.GetEnd() > r.GetStart() {
+			end = types.Int64Value(int64(r.GetEnd()))
+		}
+		step := int64(r.GetStep())
+		if step == 0 {
+			step = 1
+		}
+		res = append(res, scheduleRangeModel{
+			Start: types.Int64Value(int64(r.GetStart())),
+			End:   end,
+			Step:  types.Int64Value(step),
+		})
+	}
+	return res
+}
+
+func (m scheduleCalendarModel) sortKey() string {
+	var b strings.Builder
+	for _, ranges := range [][]scheduleRangeModel{m.Year, m.Month, m.DayOfMonth, m.DayOfWeek, m.Hour, m.Minute, m.Second} {
+		b.WriteString(scheduleRangesKey(ranges))
+		b.WriteString(";")
+	}
+	b.WriteString(m.Comment.ValueString())
+	return b.String()
+}
+
+func scheduleRangesKey(ranges []scheduleRangeModel) string {
+	var b strings.Builder
+	for _, r := range ranges {
+		fmt.Fprintf(&b, "%04d-%04d/%04d,", r.Start.ValueInt64(), r.End.ValueInt64(), r.Step.ValueInt64())
+	}
+	return b.String()
+}
+
+// scheduleCalendarDefaults are the ranges the client sets on the omitted
+// fields of a calendar: the first second of every day.
+var scheduleCalendarDefaults = scheduleCalendarModel{
+	Second:     scheduleRangeModels(0, 0),
+	Minute:     scheduleRangeModels(0, 0),
+	Hour:       scheduleRangeModels(0, 0),
+	DayOfMonth: scheduleRangeModels(1, 31),
+	Month:      scheduleRangeModels(1, 12),
+	DayOfWeek:  scheduleRangeModels(0, 6),
+}
+
+// scheduleSkipDefaults are the ranges set on the omitted fields of a skip
+// calendar, so that it skips whole days unless narrowed down.
+var scheduleSkipDefaults = scheduleCalendarModel{
+	Second:     scheduleRangeModels(0, 59),
+	Minute:     scheduleRangeModels(0, 59),
+	Hour:       scheduleRangeModels(0, 23),
+	DayOfMonth: scheduleRangeModels(1, 31),
+	Month:      scheduleRangeModels(1, 12),
+	DayOfWeek:  scheduleRangeModels(0, 6),
+}
+
+// scheduleRangeModels returns a single range, as read back from the server.
+func scheduleRangeModels(start, end int64) []scheduleRangeModel {
+	r := scheduleRangeModel{
+		Start: types.Int64Value(start),
+		End:   types.Int64Null(),
+		Step:  types.Int64Value(1),
+	}
+	if end > start {
+		r.End = types.Int64Value(end)
+	}
+	return []scheduleRangeModel{r}
+}
+
+// fields returns the range fields of the calendar.
+func (m *scheduleCalendarModel) fields() []*[]scheduleRangeModel {
+	return []*[]scheduleRangeModel{&m.Second, &m.Minute, &m.Hour, &m.DayOfMonth, &m.Month, &m.Year, &m.DayOfWeek}
+}
+
+// withDefaults sets the omitted fields of the calendar to the given defaults.
+func (m scheduleCalendarModel) withDefaults(defaults scheduleCalendarModel) scheduleCalendarModel {
+	fields, defaultFields := m.fields(), defaults.fields()
+	for i, f := range fields {
+		if len(*f) == 0 {
+			*f = *defaultFields[i]
+		}
+	}
+	return m
+}
+
+func withoutDefaultRanges(ranges, defaults []scheduleRangeModel) []scheduleRangeModel {
+	if len(defaults) > 0 && scheduleRangesKey(ranges) == scheduleRangesKey(defaults) {
+		return nil
+	}
+	return ranges
+}
+
+// normalized returns the calendar as it is read back from the server once set.
+func (m scheduleCalendarModel) normalized(defaults scheduleCalendarModel) scheduleCalendarModel {
+	spec := m.scheduleCalendarSpec(defaults)
+	return parseScheduleCalendar(&schedule.StructuredCalendarSpec{
+		Second:     scheduleRangesPB(spec.Second),
+		Minute:     scheduleRangesPB(spec.Minute),
+		Hour:       scheduleRangesPB(spec.Hour),
+		DayOfMonth: scheduleRangesPB(spec.DayOfMonth),
+		Month:      scheduleRangesPB(spec.Month),
+		Year:       scheduleRangesPB(spec.Year),
+		DayOfWeek:  scheduleRangesPB(spec.DayOfWeek),
+		Comment:    spec.Comment,
+	}, defaults)
+}
+
+func scheduleRangesPB(ranges []temporal.ScheduleRange) []*schedule.Range {
+	res := make([]*schedule.Range, 0, len(ranges))
+	for _, r := range ranges {
+		res = append(res, &schedule.Range{Start: int32(r.Start), End: int32(r.End), Step: int32(r.Step)})
+	}
+	return res
+}
+
+// matchScheduleCalendars replaces the calendars read from the server with the
+// configured calendars they are equivalent to, e.g. when a field is set to its
+// default in the configuration. The calendars matching no configured one are
+// returned separately.
+func matchScheduleCalendars(parsed, configured []scheduleCalendarModel, defaults scheduleCalendarModel) ([]scheduleCalendarModel, []scheduleCalendarModel) {
+	byKey := make(map[string][]scheduleCalendarModel, len(configured))
+	for _, c := range configured {
+		key := c.normalized(defaults).sortKey()
+		byKey[key] = append(byKey[key], c)
+	}
+
+	matched := make([]scheduleCalendarModel, 0, len(parsed))
+	var unmatched []scheduleCalendarModel
+	for _, c := range parsed {
+		key := c.sortKey()
+		if candidates := byKey[key]; len(candidates) > 0 {
+			matched = append(matched, candidates[0])
+			byKey[key] = candidates[1:]
+			continue
+		}
+		unmatched = append(unmatched, c)
+	}
+	return matched, unmatched
+}
+
+// matchScheduleIntervals replaces the intervals read from the server with the
+// configured intervals of the same durations. The intervals matching no
+// configured one are returned separately.
+func matchScheduleIntervals(parsed, configured []scheduleIntervalModel) ([]scheduleIntervalModel, []scheduleIntervalModel) {
+	used := make([]bool, len(configured))
+	matched := make([]scheduleIntervalModel, 0, len(parsed))
+	var unmatched []scheduleIntervalModel
+	for _, i := range parsed {
+		found := false
+		for j, c := range configured {
+			if !used[j] && equivalentDurations(i.Every, c.Every) && equivalentDurations(i.Offset, c.Offset) {
+				matched = append(matched, c)
+				used[j], found = true, true
+				break
+			}
+		}
+		if !found {
+			unmatched = append(unmatched, i)
+		}
+	}
+	return matched, unmatched
+}
+
+// matchConfigured keeps the configured representation of the spec read from
+// the server wherever both are equivalent, so that only actual changes show up
+// as drift.
+//
+// The server translates cron expressions into calendars, or intervals for
+// "@every", which cannot be told apart from configured ones. The configured
+// cron expressions are kept as long as the calendars and intervals matching no
+// configured one are exactly their translation.
+func (m *scheduleSpecModel) matchConfigured(configured scheduleSpecModel) {
+	calendars, cronCalendars := matchScheduleCalendars(m.Calendars, configured.Calendars, scheduleCalendarDefaults)
+	intervals, cronIntervals := matchScheduleIntervals(m.Intervals, configured.Intervals)
+	skip, unmatchedSkip := matchScheduleCalendars(m.Skip, configured.Skip, scheduleSkipDefaults)
+	m.Skip = append(skip, unmatchedSkip...)
+
+	if configured.CronExpressions != nil && matchCronExpressions(configured.CronExpressions, cronCalendars, cronIntervals) {
+		m.CronExpressions = configured.CronExpressions
+		cronCalendars, cronIntervals = nil, nil
+
+		// A CRON_TZ prefix sets the time zone of the whole spec.
+		if tz := cronTimeZone(configured.CronExpressions); configured.TimeZone.IsNull() && tz != "" && tz == m.TimeZone.ValueString() {
+			m.TimeZone = configured.TimeZone
+		}
+	}
+	m.Calendars = append(calendars, cronCalendars...)
+	m.Intervals = append(intervals, cronIntervals...)
+
+	if equivalentTimestamps(m.StartAt, configured.StartAt) {
+		m.StartAt = configured.StartAt
+	}
+	if equivalentTimestamps(m.EndAt, configured.EndAt) {
+		m.EndAt = configured.EndAt
+	}
+	if equivalentDurations(m.Jitter, configured.Jitter) {
+		m.Jitter = configured.Jitter
+	}
+}
+
+// matchCronExpressions reports whether the calendars and intervals are the
+// translation of the cron expressions by the server.
+func matchCronExpressions(expressions []basetypes.StringValue, calendars []scheduleCalendarModel, intervals []scheduleIntervalModel) bool {
+	var cronCalendars []scheduleCalendarModel
+	var cronIntervals []scheduleIntervalModel
+	for _, e := range expressions {
+		calendar, interval, err := parseCronExpression(e.ValueString())
+		if err != nil {
+			return false
+		}
+		if calendar != nil {
+			cronCalendars = append(cronCalendars, parseScheduleCalendar(calendar, scheduleCalendarDefaults))
+		}
+		if interval != nil {
+			cronIntervals = append(cronIntervals, scheduleIntervalModel{
+				Every:  types.StringValue(formatDuration(interval.GetInterval().AsDuration())),
+				Offset: types.StringValue(formatDuration(interval.GetPhase().AsDuration())),
+			})
+		}
+	}
+	if len(calendars) != len(cronCalendars) || len(intervals) != len(cronIntervals) {
+		return false
+	}
+
+	_, unmatchedCalendars := matchScheduleCalendars(calendars, cronCalendars, scheduleCalendarDefaults)
+	_, unmatchedIntervals := matchScheduleIntervals(intervals, cronIntervals)
+	return len(unmatchedCalendars) == 0 && len(unmatchedIntervals) == 0
+}
+
+// cronTimeZone returns the time zone set by a CRON_TZ or TZ prefix of the cron
+// expressions, if any.
+func cronTimeZone(expressions []basetypes.StringValue) string {
+	for _, e := range expressions {
+		expr := strings.TrimSpace(e.ValueString())
+		if strings.HasPrefix(expr, "CRON_TZ=") || strings.HasPrefix(expr, "TZ=") {
+			prefix, _, _ := strings.Cut(expr, " ")
+			_, tz, _ := strings.Cut(prefix, "=")
+			return tz
+		}
+	}
+	return ""
+}
+
+// equivalentTimestamps reports whether both values are the same RFC3339
+// instant, e.g. "2025-01-01T01:00:00+01:00" and "2025-01-01T00:00:00Z".
+func equivalentTimestamps(a, b basetypes.StringValue) bool {
+	if a.IsNull() || a.IsUnknown() || b.IsNull() || b.IsUnknown() {
+		return false
+	}
+	ta, errA := time.Parse(time.RFC3339, a.ValueString())
+	tb, errB := time.Parse(time.RFC3339, b.ValueString())
+	return errA == nil && errB == nil && ta.Equal(tb)
+}
+
+// parseScheduleTimestamp formats an optional server timestamp as RFC3339.
+func parseScheduleTimestamp(t *timestamppb.Timestamp) basetypes.StringValue {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.AsTime().UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"go.temporal.io/api/schedule/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// serverCalendar returns the calendar as stored by the server once sent by the
+// client.
+func serverCalendar(c scheduleCalendarModel, defaults scheduleCalendarModel) *schedule.StructuredCalendarSpec {
+	spec := c.scheduleCalendarSpec(defaults)
+	return &schedule.StructuredCalendarSpec{
+		Second:     scheduleRangesPB(spec.Second),
+		Minute:     scheduleRangesPB(spec.Minute),
+		Hour:       scheduleRangesPB(spec.Hour),
+		DayOfMonth: scheduleRangesPB(spec.DayOfMonth),
+		Month:      scheduleRangesPB(spec.Month),
+		Year:       scheduleRangesPB(spec.Year),
+		DayOfWeek:  scheduleRangesPB(spec.DayOfWeek),
+		Comment:    spec.Comment,
+	}
+}
+
+func TestParseScheduleCalendarsOmitsDefaults(t *testing.T) {
+	configured := scheduleCalendarModel{
+		Hour:    scheduleRangeModels(9, 9),
+		Comment: types.StringValue(""),
+	}
+
+	parsed := parseScheduleCalendars([]*schedule.StructuredCalendarSpec{serverCalendar(configured, scheduleCalendarDefaults)}, scheduleCalendarDefaults)
+	if len(parsed) != 1 || parsed[0].sortKey() != configured.sortKey() {
+		t.Errorf("got calendars %+v, want %+v", parsed, configured)
+	}
+
+	skip := scheduleCalendarModel{
+		DayOfMonth: scheduleRangeModels(25, 25),
+		Month:      scheduleRangeModels(12, 12),
+		Comment:    types.StringValue("Christmas day"),
+	}
+	stored := serverCalendar(skip, scheduleSkipDefaults)
+	if got := stored.GetHour(); len(got) != 1 || got[0].GetStart() != 0 || got[0].GetEnd() != 23 {
+		t.Errorf("skip calendar does not match whole days, got hours %v", got)
+	}
+	parsed = parseScheduleCalendars([]*schedule.StructuredCalendarSpec{stored}, scheduleSkipDefaults)
+	if len(parsed) != 1 || parsed[0].sortKey() != skip.sortKey() {
+		t.Errorf("got skip calendars %+v, want %+v", parsed, skip)
+	}
+}
+
+func TestScheduleSpecMatchConfigured(t *testing.T) {
+	explicitDefaults := scheduleCalendarModel{
+		Second:  []scheduleRangeModel{{Start: types.Int64Value(0), End: types.Int64Value(0), Step: types.Int64Value(1)}},
+		Hour:    scheduleRangeModels(9, 9),
+		Month:   scheduleRangeModels(1, 12),
+		Comment: types.StringValue(""),
+	}
+	cronCalendar := scheduleCalendarModel{
+		Hour:      scheduleRangeModels(3, 3),
+		DayOfWeek: scheduleRangeModels(1, 5),
+		Comment:   types.StringValue(""),
+	}
+	editedCronCalendar := scheduleCalendarModel{
+		Hour:    scheduleRangeModels(5, 5),
+		Comment: types.StringValue(""),
+	}
+	cron := []basetypes.StringValue{types.StringValue("0 3 * * MON-FRI")}
+
+	tests := []struct {
+		name           string
+		server         []*schedule.StructuredCalendarSpec
+		configured     scheduleSpecModel
+		wantCalendars  int
+		wantConfigured bool
+		wantCron       bool
+	}{
+		{
+			name:           "explicit default ranges",
+			server:         []*schedule.StructuredCalendarSpec{serverCalendar(explicitDefaults, scheduleCalendarDefaults)},
+			configured:     scheduleSpecModel{Calendars: []scheduleCalendarModel{explicitDefaults}},
+			wantCalendars:  1,
+			wantConfigured: true,
+		},
+		{
+			name:           "cron expression translated into a calendar",
+			server:         []*schedule.StructuredCalendarSpec{serverCalendar(explicitDefaults, scheduleCalendarDefaults), serverCalendar(cronCalendar, scheduleCalendarDefaults)},
+			configured:     scheduleSpecModel{Calendars: []scheduleCalendarModel{explicitDefaults}, CronExpressions: cron},
+			wantCalendars:  1,
+			wantConfigured: true,
+			wantCron:       true,
+		},
+		{
+			name:           "cron calendar replaced outside of Terraform",
+			server:         []*schedule.StructuredCalendarSpec{serverCalendar(explicitDefaults, scheduleCalendarDefaults), serverCalendar(editedCronCalendar, scheduleCalendarDefaults)},
+			configured:     scheduleSpecModel{Calendars: []scheduleCalendarModel{explicitDefaults}, CronExpressions: cron},
+			wantCalendars:  2,
+			wantConfigured: true,
+			wantCron:       false,
+		},
+		{
+			name:          "calendar changed outside of Terraform",
+			server:        []*schedule.StructuredCalendarSpec{serverCalendar(cronCalendar, scheduleCalendarDefaults)},
+			configured:    scheduleSpecModel{Calendars: []scheduleCalendarModel{explicitDefaults}},
+			wantCalendars: 1,
+		},
+		{
+			name:          "imported cron expression",
+			server:        []*schedule.StructuredCalendarSpec{serverCalendar(cronCalendar, scheduleCalendarDefaults)},
+			configured:    scheduleSpecModel{},
+			wantCalendars: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := scheduleSpecModel{
+				Calendars: parseScheduleCalendars(tt.server, scheduleCalendarDefaults),
+				Jitter:    types.StringValue("1m"),
+			}
+			tt.configured.Jitter = types.StringValue("60s")
+			spec.matchConfigured(tt.configured)
+
+			if len(spec.Calendars) != tt.wantCalendars {
+				t.Fatalf("got %d calendars, want %d", len(spec.Calendars), tt.wantCalendars)
+			}
+			if got := spec.CronExpressions != nil; got != tt.wantCron {
+				t.Errorf("got cron expressions %v, want kept: %v", spec.CronExpressions, tt.wantCron)
+			}
+			if spec.Jitter.ValueString() != "60s" {
+				t.Errorf("got jitter %s, want the configured 60s", spec.Jitter)
+			}
+
+			matched := false
+			for _, c := range spec.Calendars {
+				for _, configured := range tt.configured.Calendars {
+					if c.sortKey() == configured.sortKey() {
+						matched = true
+					}
+				}
+			}
+			if matched != tt.wantConfigured {
+				t.Errorf("got configured calendar kept: %v, want %v", matched, tt.wantConfigured)
+			}
+		})
+	}
+}
+
+func TestParseCronExpression(t *testing.T) {
+	r := func(start, end, step int32) *schedule.Range {
+		return &schedule.Range{Start: start, End: end, Step: step}
+	}
+
+	tests := []struct {
+		expr         string
+		wantCalendar *schedule.StructuredCalendarSpec
+		wantInterval *schedule.IntervalSpec
+		wantErr      bool
+	}{
+		{
+			expr: "0 3 * * MON-FRI",
+			wantCalendar: &schedule.StructuredCalendarSpec{
+				Second:    []*schedule.Range{r(0, 0, 1)},
+				Minute:    []*schedule.Range{r(0, 0, 1)},
+				Hour:      []*schedule.Range{r(3, 3, 1)},
+				DayOfWeek: []*schedule.Range{r(1, 5, 1)},
+			},
+		},
+		{
+			expr: "CRON_TZ=Europe/Paris */15 8,18 1 jan-MAR * # Quarterly",
+			wantCalendar: &schedule.StructuredCalendarSpec{
+				Second:     []*schedule.Range{r(0, 0, 1)},
+				Minute:     []*schedule.Range{r(0, 59, 15)},
+				Hour:       []*schedule.Range{r(8, 8, 1), r(18, 18, 1)},
+				DayOfMonth: []*schedule.Range{r(1, 1, 1)},
+				Month:      []*schedule.Range{r(1, 3, 1)},
+				Comment:    "Quarterly",
+			},
+		},
+		{
+			expr: "@weekly",
+			wantCalendar: &schedule.StructuredCalendarSpec{
+				Second:    []*schedule.Range{r(0, 0, 1)},
+				Minute:    []*schedule.Range{r(0, 0, 1)},
+				Hour:      []*schedule.Range{r(0, 0, 1)},
+				DayOfWeek: []*schedule.Range{r(0, 0, 1)},
+			},
+		},
+		{
+			expr:         "@every 2d/1h",
+			wantInterval: &schedule.IntervalSpec{Interval: durationpb.New(48 * time.Hour), Phase: durationpb.New(time.Hour)},
+		},
+		{expr: "0 25 * * *", wantErr: true},
+		{expr: "0 3 * *", wantErr: true},
+		{expr: "CRON_TZ=UTC", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			calendar, interval, err := parseCronExpression(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %v", err, tt.wantErr)
+			}
+			if !proto.Equal(calendar, tt.wantCalendar) {
+				t.Errorf("got calendar %v, want %v", calendar, tt.wantCalendar)
+			}
+			if !proto.Equal(interval, tt.wantInterval) {
+				t.Errorf("got interval %v, want %v", interval, tt.wantInterval)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"go.temporal.io/api/workflowservice/v1"
+	temporal "go.temporal.io/sdk/client"
+)
+
+// schedulesPageSize is the number of schedules fetched per ListSchedules call.
+const schedulesPageSize = 100
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &schedulesDataSource{}
+	_ datasource.DataSourceWithConfigure = &schedulesDataSource{}
+)
+
+func NewSchedulesDataSource() datasource.DataSource {
+	return &schedulesDataSource{}
+}
+
+type schedulesDataSource struct {
+	client    temporal.Client
+	namespace string
+}
+
+type scheduleListEntryModel struct {
+	Name            basetypes.StringValue       `tfsdk:"name"`
+	IsPaused        basetypes.BoolValue         `tfsdk:"is_paused"`
+	Note            basetypes.StringValue       `tfsdk:"note"`
+	WorkflowType    basetypes.StringValue       `tfsdk:"workflow_type"`
+	NextActionTimes []basetypes.StringValue     `tfsdk:"next_action_times"`
+	RecentActions   []scheduleActionResultModel `tfsdk:"recent_actions"`
+}
+
+type schedulesDataSourceModel struct {
+	Namespace basetypes.StringValue    `tfsdk:"namespace"`
+	Query     basetypes.StringValue    `tfsdk:"query"`
+	Schedules []scheduleListEntryModel `tfsdk:"schedules"`
+}
+
+func (d *schedulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = cfg.client
+	d.namespace = cfg.namespace
+}
+
+// Metadata returns the data source type name.
+func (d *schedulesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_schedules"
+}
+
+// Schema defines the schema for the data source.
+func (d *schedulesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `temporal_schedules` data source allows you to list the schedules of a namespace, optionally filtered with a visibility query.",
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				Description: "Namespace to list the schedules of. Defaults to the provider namespace.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Visibility query filtering the schedules, e.g. `TemporalSchedulePaused = true`. Lists all the schedules when unset.",
+				Optional:            true,
+			},
+			"schedules": schema.ListNestedAttribute{
+				Description: "Schedules matching the query.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Schedule name.",
+							Computed:    true,
+						},
+						"is_paused": schema.BoolAttribute{
+							Description: "Whether that schedule is currently paused.",
+							Computed:    true,
+						},
+						"note": schema.StringAttribute{
+							Description: "Note left when the schedule was last paused or unpaused.",
+							Computed:    true,
+						},
+						"workflow_type": schema.StringAttribute{
+							Description: "Name of the workflow definition this schedule starts.",
+							Computed:    true,
+						},
+						"next_action_times": scheduleNextActionTimesAttribute(),
+						"recent_actions":    scheduleActionResultsAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *schedulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data schedulesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := d.namespace
+	if data.Namespace.ValueString() != "" {
+		namespace = data.Namespace.ValueString()
+	}
+
+	// The ScheduleListOptions of ScheduleClient().List have no query field,
+	// list the schedules with the workflow service instead.
+	data.Schedules = make([]scheduleListEntryModel, 0)
+	var nextPageToken []byte
+	for {
+		page, err := d.client.WorkflowService().ListSchedules(ctx, &workflowservice.ListSchedulesRequest{
+			Namespace:       namespace,
+			MaximumPageSize: schedulesPageSize,
+			NextPageToken:   nextPageToken,
+			Query:           data.Query.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing the schedules of namespace "+namespace, serviceErrorDetail(err))
+			return
+		}
+
+		for _, schedule := range page.GetSchedules() {
+			info := schedule.GetInfo()
+			data.Schedules = append(data.Schedules, scheduleListEntryModel{
+				Name:            types.StringValue(schedule.GetScheduleId()),
+				IsPaused:        types.BoolValue(info.GetPaused()),
+				Note:            types.StringValue(info.GetNotes()),
+				WorkflowType:    types.StringValue(info.GetWorkflowType().GetName()),
+				NextActionTimes: parseScheduleActionTimes(info.GetFutureActionTimes()),
+				RecentActions:   parseScheduleActionResults(info.GetRecentActions()),
+			})
+		}
+
+		nextPageToken = page.GetNextPageToken()
+		if len(nextPageToken) == 0 {
+			break
+		}
+	}
+
+	data.Namespace = types.StringValue(namespace)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"terraform-provider-temporal/internal/validators"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/operatorservice/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	temporal "go.temporal.io/sdk/client"
+)
+
+const (
+	// searchAttributeCreateTimeout bounds the creation of a search attribute,
+	// including the wait for it to become queryable, when not set in the
+	// timeouts block of the resource.
+	searchAttributeCreateTimeout = 5 * time.Minute
+	searchAttributeWaitInterval  = time.Second
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &searchAttributeResource{}
+	_ resource.ResourceWithConfigure   = &searchAttributeResource{}
+	_ resource.ResourceWithImportState = &searchAttributeResource{}
+)
+
+func NewSearchAttributeResource() resource.Resource {
+	return &searchAttributeResource{}
+}
+
+type searchAttributeResource struct {
+	client    temporal.Client
+	namespace string
+}
+
+type searchAttributeResourceModel struct {
+	Namespace basetypes.StringValue `tfsdk:"namespace"`
+	Name      basetypes.StringValue `tfsdk:"name"`
+	Type      basetypes.StringValue `tfsdk:"type"`
+	Timeouts  timeouts.Value        `tfsdk:"timeouts"`
+}
+
+func (r *searchAttributeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	cfg, ok := req.ProviderData.(*providerConfig)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.providerConfig, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = cfg.client
+	r.namespace = cfg.namespace
+}
+
+// searchAttributeNamespace returns the namespace of a search attribute,
+// falling back to the provider namespace when none is set.
+func (r *searchAttributeResource) searchAttributeNamespace(namespace basetypes.StringValue) string {
+	if namespace.IsNull() || namespace.IsUnknown() || namespace.ValueString() == "" {
+		return r.namespace
+	}
+	return namespace.ValueString()
+}
+
+// Metadata returns the resource type name.
+func (r *searchAttributeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_search_attribute"
+}
+
+// Schema defines the schema for the resource.
+func (r *searchAttributeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "The `temporal_search_attribute` resource allows you to register custom search attributes on a namespace, which can then be used to filter workflows in visibility queries.",
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{
+				Description: "Namespace the search attribute belongs to. Defaults to the provider namespace.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the search attribute.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the search attribute. Accepted values: `Text`, `Keyword`, `Int`, `Double`, `Bool`, `Datetime`, `KeywordList`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.StringInSliceValidator{
+						AllowedValues: searchAttributeTypes,
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *searchAttributeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data *searchAttributeResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := r.searchAttributeNamespace(data.Namespace)
+	name := data.Name.ValueString()
+	valueType, err := enums.IndexedValueTypeFromString(data.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid search attribute type", err.Error())
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, searchAttributeCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	_, err = r.client.OperatorService().AddSearchAttributes(ctx, &operatorservice.AddSearchAttributesRequest{
+		Namespace:        namespace,
+		SearchAttributes: map[string]enums.IndexedValueType{name: valueType},
+	})
+	// A previous apply may have added the search attribute, then failed
+	// waiting for it. Adopt it if it has the planned type.
+	if isAlreadyExists(err) {
+		err = r.checkType(ctx, namespace, name, valueType)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating search attribute",
+			"Could not add search attribute "+name+" to namespace "+namespace+", unexpected error: "+serviceErrorDetail(err),
+		)
+		return
+	}
+
+	// Save the state before waiting, so that the search attribute is not
+	// orphaned when the wait fails.
+	data.Namespace = types.StringValue(namespace)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.waitQueryable(ctx, namespace, name); err != nil {
+		resp.Diagnostics.AddError("Search attribute "+name+" did not become queryable", serviceErrorDetail(err))
+		return
+	}
+}
+
+// checkType returns an error unless the existing search attribute has the
+// given type.
+func (r *searchAttributeResource) checkType(ctx context.Context, namespace string, name string, valueType enums.IndexedValueType) error {
+	attributes, err := r.client.OperatorService().ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return err
+	}
+	existing, ok := attributes.GetCustomAttributes()[name]
+	if !ok {
+		return fmt.Errorf("search attribute %s is reported as existing but is not listed on namespace %s", name, namespace)
+	}
+	if existing != valueType {
+		return fmt.Errorf("search attribute %s already exists on namespace %s with type %s", name, namespace, existing)
+	}
+	return nil
+}
+
+// waitQueryable waits until the search attribute is listed on the namespace
+// and can be used in a visibility query, or until the context is done.
+func (r *searchAttributeResource) waitQueryable(ctx context.Context, namespace string, name string) error {
+	var lastErr error
+	for {
+		lastErr = r.queryable(ctx, namespace, name)
+		if lastErr == nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timed out waiting for the search attribute: %w", lastErr)
+		case <-time.After(searchAttributeWaitInterval):
+		}
+	}
+}
+
+func (r *searchAttributeResource) queryable(ctx context.Context, namespace string, name string) error {
+	attributes, err := r.client.OperatorService().ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{
+		Namespace: namespace,
+	})
+	if err != nil {
+		return err
+	}
+	if _, ok := attributes.GetCustomAttributes()[name]; !ok {
+		return fmt.Errorf("search attribute %s is not listed on namespace %s yet", name, namespace)
+	}
+
+	_, err = r.client.WorkflowService().CountWorkflowExecutions(ctx, &workflowservice.CountWorkflowExecutionsRequest{
+		Namespace: namespace,
+		Query:     name + " IS NOT NULL",
+	})
+	return err
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *searchAttributeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data *searchAttributeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := r.searchAttributeNamespace(data.Namespace)
+	name := data.Name.ValueString()
+
+	attributes, err := r.client.OperatorService().ListSearchAttributes(ctx, &operatorservice.ListSearchAttributesRequest{
+		Namespace: namespace,
+	})
+	if isNotFound(err) {
+		// The namespace is gone, and the search attribute along with it.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching the search attributes of namespace "+namespace, serviceErrorDetail(err))
+		return
+	}
+
+	valueType, ok := attributes.GetCustomAttributes()[name]
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Namespace = types.StringValue(namespace)
+	data.Type = types.StringValue(valueType.String())
+
+	diags := resp.State.Set(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called as every attribute requires a replacement.
+func (r *searchAttributeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError("Search attributes cannot be updated", "Search attributes must be replaced to change them. Please report this issue to the provider developers.")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *searchAttributeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data searchAttributeResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	namespace := r.searchAttributeNamespace(data.Namespace)
+	_, err := r.client.OperatorService().RemoveSearchAttributes(ctx, &operatorservice.RemoveSearchAttributesRequest{
+		Namespace:        namespace,
+		SearchAttributes: []string{data.Name.ValueString()},
+	})
+	// The search attribute or its namespace is already gone, nothing to delete.
+	if isNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error while deleting search attribute "+data.Name.ValueString()+" of namespace "+namespace, serviceErrorDetail(err))
+		return
+	}
+}
+
+// ImportState imports a search attribute either by its name, in the provider
+// namespace, or as "namespace/name".
+func (r *searchAttributeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	namespace, name := splitImportID(req.ID, r.namespace)
+	switch {
+	case namespace == "":
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"The namespace is empty in the import ID "+req.ID+". Expected an import ID of the form \"name\" or \"namespace/name\".",
+		)
+		return
+	case name == "" || strings.Contains(name, "/"):
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			"The search attribute name is empty or contains \"/\" in the import ID "+req.ID+". Expected an import ID of the form \"name\" or \"namespace/name\".",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSearchAttributeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testProviderConfig + `
+resource "temporal_search_attribute" "example" {
+  name = "ExampleRegion"
+  type = "Keyword"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_search_attribute.example", "namespace", "default"),
+					resource.TestCheckResourceAttr("temporal_search_attribute.example", "name", "ExampleRegion"),
+					resource.TestCheckResourceAttr("temporal_search_attribute.example", "type", "Keyword"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "temporal_search_attribute.example",
+				ImportState:                          true,
+				ImportStateId:                        "default/ExampleRegion",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Replace testing
+			{
+				Config: testProviderConfig + `
+resource "temporal_search_attribute" "example" {
+  name = "ExampleRegion"
+  type = "KeywordList"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("temporal_search_attribute.example", "type", "KeywordList"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestSearchAttributeImportState(t *testing.T) {
+	tests := []struct {
+		id            string
+		wantNamespace string
+		wantName      string
+		wantErr       bool
+	}{
+		{id: "CustomerId", wantNamespace: "default", wantName: "CustomerId"},
+		{id: "other/CustomerId", wantNamespace: "other", wantName: "CustomerId"},
+		{id: "", wantErr: true},
+		{id: "/CustomerId", wantErr: true},
+		{id: "other/", wantErr: true},
+		{id: "other/Customer/Id", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			namespace, name, diags := importState(t, &searchAttributeResource{namespace: "default"}, tt.id)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if namespace != tt.wantNamespace || name != tt.wantName {
+				t.Errorf("imported %q/%q, want %q/%q", namespace, name, tt.wantNamespace, tt.wantName)
+			}
+		})
+	}
+}
@@ -3,7 +3,11 @@ package provider
 import (
 	"errors"
 	"strconv"
+	"strings"
 	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 )
 
 func parseDuration(durationStr string) (time.Duration, error) {
@@ -61,3 +65,34 @@ func formatDuration(duration time.Duration) string {
 
 	return strconv.FormatInt(value, 10) + unit
 }
+
+// equivalentDurations reports whether both values are the same duration, e.g.
+// "60m" and "1h".
+func equivalentDurations(a, b basetypes.StringValue) bool {
+	if a.IsNull() || a.IsUnknown() || b.IsNull() || b.IsUnknown() {
+		return false
+	}
+	da, errA := parseDuration(a.ValueString())
+	db, errB := parseDuration(b.ValueString())
+	return errA == nil && errB == nil && da == db
+}
+
+// optionalStringValue maps an empty string to a null value.
+func optionalStringValue(s string) basetypes.StringValue {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+// splitImportID splits an import ID of the form "namespace/id" into the
+// namespace and the ID, which may itself contain "/". Namespace names cannot
+// contain "/", so an ID without "/" is in the default namespace, while an ID
+// containing "/" must always be prefixed with its namespace.
+func splitImportID(importID, defaultNamespace string) (namespace, id string) {
+	namespace, id, found := strings.Cut(importID, "/")
+	if !found {
+		return defaultNamespace, importID
+	}
+	return namespace, id
+}
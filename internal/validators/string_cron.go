@@ -0,0 +1,160 @@
+package validators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type StringCronValidator struct{}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string
+}
+
+var (
+	cronSecond     = cronField{name: "second", min: 0, max: 59}
+	cronMinute     = cronField{name: "minute", min: 0, max: 59}
+	cronHour       = cronField{name: "hour", min: 0, max: 23}
+	cronDayOfMonth = cronField{name: "day of month", min: 1, max: 31}
+	cronMonth      = cronField{name: "month", min: 1, max: 12, names: []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}}
+	cronDayOfWeek  = cronField{name: "day of week", min: 0, max: 7, names: []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}}
+	cronYear       = cronField{name: "year", min: 1970, max: 9999}
+)
+
+func (v StringCronValidator) Description(ctx context.Context) string {
+	return "Ensures the string is a valid cron expression."
+}
+
+func (v StringCronValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures the string is a valid cron expression. E.g `0 3 * * MON-FRI` or `@daily`."
+}
+
+func (v StringCronValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateCronExpression(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Value at "+req.PathExpression.String(),
+			"The value must be a valid cron expression: "+err.Error(),
+		)
+	}
+}
+
+// validateCronExpression checks an expression against the cron syntax accepted
+// by the Temporal server.
+func validateCronExpression(expr string) error {
+	expr = strings.TrimSpace(expr)
+
+	// Optional time zone prefix.
+	if strings.HasPrefix(expr, "CRON_TZ=") || strings.HasPrefix(expr, "TZ=") {
+		var tz string
+		tz, expr, _ = strings.Cut(expr, " ")
+		if _, name, _ := strings.Cut(tz, "="); name == "" {
+			return errors.New("empty time zone name")
+		}
+		expr = strings.TrimSpace(expr)
+	}
+
+	// Optional trailing comment.
+	expr, _, _ = strings.Cut(expr, "#")
+	expr = strings.TrimSpace(expr)
+
+	if strings.HasPrefix(expr, "@") {
+		return validateCronShorthand(expr)
+	}
+
+	var fields []cronField
+	parts := strings.Fields(expr)
+	switch len(parts) {
+	case 5:
+		fields = []cronField{cronMinute, cronHour, cronDayOfMonth, cronMonth, cronDayOfWeek}
+	case 6:
+		fields = []cronField{cronMinute, cronHour, cronDayOfMonth, cronMonth, cronDayOfWeek, cronYear}
+	case 7:
+		fields = []cronField{cronSecond, cronMinute, cronHour, cronDayOfMonth, cronMonth, cronDayOfWeek, cronYear}
+	default:
+		return fmt.Errorf("expected 5 to 7 fields, got %d", len(parts))
+	}
+
+	for i, part := range parts {
+		if err := fields[i].validate(part); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateCronShorthand(expr string) error {
+	switch expr {
+	case "@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly":
+		return nil
+	}
+
+	every, found := strings.CutPrefix(expr, "@every ")
+	if !found {
+		return fmt.Errorf("unknown shorthand %q", expr)
+	}
+	interval, phase, hasPhase := strings.Cut(strings.TrimSpace(every), "/")
+	if !isDuration(interval) || (hasPhase && !isDuration(phase)) {
+		return fmt.Errorf("invalid interval %q, expected e.g. \"@every 30m\" or \"@every 1h/15m\"", every)
+	}
+	return nil
+}
+
+func isDuration(s string) bool {
+	if len(s) < 2 || !strings.ContainsAny(s[len(s)-1:], "smhd") {
+		return false
+	}
+	_, err := strconv.Atoi(s[:len(s)-1])
+	return err == nil
+}
+
+func (f cronField) validate(s string) error {
+	for _, item := range strings.Split(s, ",") {
+		rng, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n <= 0 {
+				return fmt.Errorf("invalid step %q in %s field", step, f.name)
+			}
+		}
+		if rng == "*" || rng == "?" {
+			continue
+		}
+		start, end, isRange := strings.Cut(rng, "-")
+		if err := f.validateValue(start); err != nil {
+			return err
+		}
+		if isRange {
+			if err := f.validateValue(end); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (f cronField) validateValue(s string) error {
+	for _, name := range f.names {
+		if strings.EqualFold(s, name) {
+			return nil
+		}
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("invalid value %q in %s field", s, f.name)
+	}
+	if n < f.min || n > f.max {
+		return fmt.Errorf("value %d out of range %d-%d in %s field", n, f.min, f.max, f.name)
+	}
+	return nil
+}
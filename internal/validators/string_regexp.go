@@ -0,0 +1,31 @@
+package validators
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type StringRegexpValidator struct{}
+
+func (v StringRegexpValidator) Description(ctx context.Context) string {
+	return "Ensures the string is a valid regular expression."
+}
+
+func (v StringRegexpValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures the string is a valid [RE2](https://github.com/google/re2/wiki/Syntax) regular expression. E.g `^team-a-.*$`."
+}
+
+func (v StringRegexpValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Value at "+req.PathExpression.String(),
+			"The value must be a valid regular expression: "+err.Error(),
+		)
+	}
+}
@@ -0,0 +1,31 @@
+package validators
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type StringRFC3339Validator struct{}
+
+func (v StringRFC3339Validator) Description(ctx context.Context) string {
+	return "Ensures the string is an RFC3339 timestamp."
+}
+
+func (v StringRFC3339Validator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures the string is an RFC3339 timestamp. E.g `2025-12-01T00:00:00Z`."
+}
+
+func (v StringRFC3339Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := time.Parse(time.RFC3339, req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Value at "+req.PathExpression.String(),
+			"The value must be an RFC3339 timestamp. E.g 2025-12-01T00:00:00Z.",
+		)
+	}
+}
@@ -0,0 +1,40 @@
+package validators
+
+import (
+	"context"
+	"time"
+	// Embed the tz database so validation doesn't depend on the host.
+	_ "time/tzdata"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+type StringTimeZoneValidator struct{}
+
+func (v StringTimeZoneValidator) Description(ctx context.Context) string {
+	return "Ensures the string is a time zone name from the tz database."
+}
+
+func (v StringTimeZoneValidator) MarkdownDescription(ctx context.Context) string {
+	return "Ensures the string is a time zone name from the tz database. E.g `UTC`, `Europe/London` or `America/New_York`."
+}
+
+func (v StringTimeZoneValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	name := req.ConfigValue.ValueString()
+	// time.LoadLocation interprets "Local" and "" as the host time zone,
+	// which the Temporal server would not resolve the same way.
+	if name != "" && name != "Local" {
+		if _, err := time.LoadLocation(name); err == nil {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddError(
+		"Invalid Value at "+req.PathExpression.String(),
+		"The value must be a time zone name from the tz database. E.g UTC, Europe/London or America/New_York.",
+	)
+}